@@ -2,44 +2,36 @@ package client
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"os"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/thanhpk/randstr"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/p0pr0ck5/volchestrator/config"
 	svc "github.com/p0pr0ck5/volchestrator/svc"
 )
 
-type notificationHandler func(*Client, *svc.Notification) error
+// ErrLeaseRequestExpired is returned by AcquireLease when the lease request
+// expired before a lease was granted
+var ErrLeaseRequestExpired = errors.New("lease request expired")
 
-var notifHandlers map[svc.NotificationType][]notificationHandler
+// ErrClientStarted is returned by Start when the client was already started
+var ErrClientStarted = errors.New("client already started")
 
-func registerNotificationHandlers() {
-	notifHandlers[svc.NotificationType_NOTIFICATIONLEASEREQUESTEXPIRED] = []notificationHandler{
-		func(client *Client, msg *svc.Notification) error {
-			client.log.Println("Handling renewal of", msg.Message, "TODO")
-			return nil
-		},
-	}
-
-	notifHandlers[svc.NotificationType_NOTIFICATIONLEASEAVAILABLE] = []notificationHandler{
-		func(client *Client, msg *svc.Notification) error {
-			client.log.Println("I shouldn't need to do anything here right?") // because we ack the notification
-			return nil
-		},
-	}
-
-	notifHandlers[svc.NotificationType_NOTIFICATIONLEASE] = []notificationHandler{
-		func(client *Client, msg *svc.Notification) error {
-			client.log.Printf("I haz lease! %+v\n", msg)
-			// DO THE THING
-			return nil
-		},
-	}
-}
+// ErrClientNotStarted is returned by methods that call the server before
+// Start has connected to it
+var ErrClientNotStarted = errors.New("client not started")
 
 // Client represents a volchestrator client
 type Client struct {
@@ -47,120 +39,888 @@ type Client struct {
 
 	ClientID string
 
+	serverAddress string
+	dialOptions   []grpc.DialOption
+
 	svcClient svc.VolchestratorClient
 	conn      *grpc.ClientConn
 
+	// ctx is cancelled when the client stops, ending its background loops
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	// stopOnce guards Stop, so concurrent calls shut the client down once
+	stopOnce sync.Once
+
+	// sessionLost wakes the loop that reconnects to the server. epoch is
+	// incremented each time the server registers the client afresh
+	sessionLost chan struct{}
+	epoch       uint64
+
+	handlers     map[svc.NotificationType][]NotificationHandler
+	handlersLock sync.RWMutex
+
+	events          chan Event
+	eventBufferSize int
+
+	// waiters are the lease requests AcquireLease is waiting on, guarded by
+	// leasesLock
+	leases     map[string]*svc.Lease
+	waiters    map[string]chan *svc.Lease
+	leasesLock sync.Mutex
+
+	renewals     map[string]*renewal
+	renewalsLock sync.Mutex
+
+	// requests are the lease requests and gang lease requests not yet
+	// granted, by their current ID, so they can be resubmitted
+	requests     map[string]*outstanding
+	requestsLock sync.Mutex
+
+	// handled holds the IDs of recently handled notifications, oldest first,
+	// so redelivered notifications are only acked
+	handled      map[string]bool
+	handledOrder []string
+	handledLock  sync.Mutex
+
 	log *log.Logger
 }
 
-// NewClient create a new client object based on a given configuration
-func NewClient(c config.ClientConfig) (*Client, error) {
+// handledNotificationsSize is how many notification IDs are remembered for
+// deduplication
+const handledNotificationsSize = 1024
+
+// submitAttempts is how many times a lease request submission is attempted
+// when the server can't be reached, each attempt timing out after
+// submitTimeout
+const submitAttempts = 5
+const submitTimeout = time.Second * 5
+
+// renewal tracks when a lease or lease request should next be renewed
+type renewal struct {
+	lease   bool
+	renewAt time.Time
+}
+
+// outstanding is a lease request or gang lease request awaiting its lease.
+// origin is the ID it was first given, which callers keep using when it is
+// resubmitted under a new ID
+type outstanding struct {
+	origin  string
+	request *svc.LeaseRequest
+	gang    *svc.GangLeaseRequest
+}
+
+// New returns a client for the server at a given address. It doesn't connect
+// until Start is called
+func New(serverAddress string, opts ...Option) (*Client, error) {
+	if serverAddress == "" {
+		return nil, errors.New("server address is required")
+	}
+
 	client := &Client{
-		Config: c,
-		log:    log.New(os.Stdout, "", log.Ldate|log.Ltime|log.Lmicroseconds|log.Lshortfile),
+		serverAddress:   serverAddress,
+		dialOptions:     []grpc.DialOption{grpc.WithInsecure()},
+		handlers:        make(map[svc.NotificationType][]NotificationHandler),
+		eventBufferSize: DefaultEventBufferSize,
+		leases:          make(map[string]*svc.Lease),
+		waiters:         make(map[string]chan *svc.Lease),
+		renewals:        make(map[string]*renewal),
+		requests:        make(map[string]*outstanding),
+		sessionLost:     make(chan struct{}, 1),
+		handled:         make(map[string]bool),
+		log:             log.New(os.Stdout, "", log.Ldate|log.Ltime|log.Lmicroseconds|log.Lshortfile),
 	}
 
-	if c.ClientID == "" {
+	// the client's own handlers run before any registered by options
+	client.registerDefaultHandlers()
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	if client.ClientID == "" {
 		client.ClientID = randstr.Hex(16)
 	}
 
+	client.events = make(chan Event, client.eventBufferSize)
+
 	return client, nil
 }
 
-// Run handles registering the client and setting up notification handlers
-func (c *Client) Run() error {
-	notifHandlers = make(map[svc.NotificationType][]notificationHandler)
-	registerNotificationHandlers()
+// NewClient create a new client object based on a given configuration. Run
+// submits the lease requests in the configuration
+func NewClient(c config.ClientConfig, opts ...Option) (*Client, error) {
+	client, err := New(c.ServerAddress, append([]Option{WithClientID(c.ClientID)}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	client.Config = c
+
+	return client, nil
+}
+
+// Start connects to the server, registers the client and starts handling
+// notifications and renewing leases in the background until Stop is called.
+// The context only bounds connecting and registering
+func (c *Client) Start(ctx context.Context) error {
+	if c.conn != nil {
+		return ErrClientStarted
+	}
 
-	conn, err := grpc.Dial(c.Config.ServerAddress, []grpc.DialOption{grpc.WithBlock(), grpc.WithInsecure()}...)
+	conn, err := grpc.DialContext(ctx, c.serverAddress, append([]grpc.DialOption{grpc.WithBlock()}, c.dialOptions...)...)
 	if err != nil {
-		c.log.Fatalf("fail to dial: %v", err)
+		return fmt.Errorf("failed to dial %s: %w", c.serverAddress, err)
 	}
 
-	c.conn = conn
 	c.svcClient = svc.NewVolchestratorClient(conn)
 
-	_, err = c.svcClient.Register(context.Background(), &svc.RegisterMessage{
-		Id: c.ClientID,
-	})
+	_, err = c.register(ctx, false)
 	if err != nil {
+		c.svcClient = nil
+		conn.Close()
 		return err
 	}
 
-	go c.WatchNotifications()
+	c.conn = conn
+	c.ctx, c.cancel = context.WithCancel(context.Background())
+
+	// take back the leases the server holds for the client's ID, e.g. when the
+	// process restarted
+	err = c.resync()
+	if err != nil {
+		c.log.Println("Failed to resync with the server:", err)
+		c.lostSession()
+	}
+
+	c.wg.Add(4)
 
 	go func() {
-		for _, request := range c.Config.LeaseRequests {
-			c.svcClient.SubmitLeaseRequest(context.Background(), &svc.LeaseRequest{
-				ClientId:         c.ClientID,
-				Tag:              request.Tag,
-				AvailabilityZone: request.AvailabilityZone,
-			})
-		}
+		defer c.wg.Done()
+		c.watchNotifications()
 	}()
 
-	go c.SendHeartbeats()
+	go func() {
+		defer c.wg.Done()
+		c.sendHeartbeats()
+	}()
+
+	go func() {
+		defer c.wg.Done()
+		c.sendRenewals()
+	}()
+
+	go func() {
+		defer c.wg.Done()
+		c.maintainSession()
+	}()
 
 	return nil
 }
 
-// SendHeartbeats sends heartbeats on a regular basis
-func (c *Client) SendHeartbeats() {
+// Run starts the client and submits the lease requests in its configuration
+func (c *Client) Run() error {
+	leaseRequests := []*svc.LeaseRequest{}
+	for _, request := range c.Config.LeaseRequests {
+		r, err := leaseRequestFromConfig(request)
+		if err != nil {
+			return err
+		}
+
+		leaseRequests = append(leaseRequests, r)
+	}
+
+	gangLeaseRequests := []*svc.GangLeaseRequest{}
+	for _, gang := range c.Config.GangLeaseRequests {
+		g := &svc.GangLeaseRequest{}
+
+		for _, request := range gang.LeaseRequests {
+			r, err := leaseRequestFromConfig(request)
+			if err != nil {
+				return err
+			}
+
+			g.Requests = append(g.Requests, r)
+		}
+
+		var err error
+		g.Ttl, err = ttlFromConfig(gang.TTL)
+		if err != nil {
+			return err
+		}
+
+		gangLeaseRequests = append(gangLeaseRequests, g)
+	}
+
+	err := c.Start(context.Background())
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for _, request := range leaseRequests {
+			id, err := c.SubmitLeaseRequest(c.ctx, request)
+			if err != nil {
+				c.log.Println(err)
+				continue
+			}
+
+			c.log.Println("Submitted lease request", id)
+		}
+
+		for _, gang := range gangLeaseRequests {
+			id, err := c.SubmitGangLeaseRequest(c.ctx, gang)
+			if err != nil {
+				c.log.Println(err)
+				continue
+			}
+
+			c.log.Println("Submitted gang lease request", id)
+		}
+	}()
+
+	return nil
+}
+
+// SubmitLeaseRequest submits a lease request, returning its ID. The request
+// is resubmitted if the server loses it before it is granted, keeping the
+// same ID as far as the client is concerned
+func (c *Client) SubmitLeaseRequest(ctx context.Context, request *svc.LeaseRequest) (string, error) {
+	if c.svcClient == nil {
+		return "", ErrClientNotStarted
+	}
+
+	id, err := c.submitLeaseRequest(ctx, request)
+	if err != nil {
+		return "", err
+	}
+
+	c.trackRequest(id, &outstanding{
+		origin:  id,
+		request: request,
+	})
+
+	return id, nil
+}
+
+// submitLeaseRequest submits a lease request, returning its ID. Transient
+// failures are retried with the same idempotency key, so a retry of a
+// submission that did reach the server doesn't create a duplicate request
+func (c *Client) submitLeaseRequest(ctx context.Context, request *svc.LeaseRequest) (string, error) {
+	request.ClientId = c.ClientID
+	if request.IdempotencyKey == "" {
+		request.IdempotencyKey = randstr.Hex(16)
+	}
+
+	return c.retrySubmission(ctx, func(ctx context.Context) (*svc.LeaseRequestID, error) {
+		return c.svcClient.SubmitLeaseRequest(ctx, request)
+	})
+}
+
+// retrySubmission makes a submission carrying an idempotency key, retrying
+// transient failures
+func (c *Client) retrySubmission(ctx context.Context, submit func(context.Context) (*svc.LeaseRequestID, error)) (string, error) {
+	var err error
+	for attempt := 0; attempt < submitAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return "", ctx.Err()
+			case <-time.After(time.Second << (attempt - 1)):
+			}
+		}
+
+		attemptCtx, cancel := context.WithTimeout(ctx, submitTimeout)
+		var res *svc.LeaseRequestID
+		res, err = submit(attemptCtx)
+		cancel()
+
+		if err == nil {
+			return res.Id, nil
+		}
+
+		if ctx.Err() != nil {
+			return "", err
+		}
+
+		if code := status.Code(err); code != codes.Unavailable && code != codes.DeadlineExceeded {
+			return "", err
+		}
+
+		c.log.Println("Retrying submission:", err)
+	}
+
+	return "", err
+}
+
+// SubmitGangLeaseRequest submits a set of lease requests to be granted
+// together, returning the gang ID. Transient failures are retried with the
+// same idempotency key, as with SubmitLeaseRequest
+func (c *Client) SubmitGangLeaseRequest(ctx context.Context, gang *svc.GangLeaseRequest) (string, error) {
+	if c.svcClient == nil {
+		return "", ErrClientNotStarted
+	}
+
+	id, err := c.submitGangLeaseRequest(ctx, gang)
+	if err != nil {
+		return "", err
+	}
+
+	c.trackRequest(id, &outstanding{
+		origin: id,
+		gang:   gang,
+	})
+
+	return id, nil
+}
+
+// submitGangLeaseRequest submits a gang lease request, returning its gang ID
+func (c *Client) submitGangLeaseRequest(ctx context.Context, gang *svc.GangLeaseRequest) (string, error) {
+	gang.ClientId = c.ClientID
+	if gang.IdempotencyKey == "" {
+		gang.IdempotencyKey = randstr.Hex(16)
+	}
+
+	return c.retrySubmission(ctx, func(ctx context.Context) (*svc.LeaseRequestID, error) {
+		return c.svcClient.SubmitGangLeaseRequest(ctx, gang)
+	})
+}
+
+// AcquireLease submits a lease request and waits for its lease to be
+// granted. If the context ends first, the lease request is cancelled
+func (c *Client) AcquireLease(ctx context.Context, request *svc.LeaseRequest) (*svc.Lease, error) {
+	id, err := c.SubmitLeaseRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *svc.Lease, 1)
+
+	// the lease may have been granted before the submission returned
+	c.leasesLock.Lock()
+	for _, l := range c.leases {
+		if l.LeaseRequestId == id {
+			c.leasesLock.Unlock()
+			return l, nil
+		}
+	}
+	c.waiters[id] = ch
+	c.leasesLock.Unlock()
+
+	select {
+	case l := <-ch:
+		if l == nil {
+			return nil, ErrLeaseRequestExpired
+		}
+
+		return l, nil
+	case <-ctx.Done():
+	}
+
+	c.leasesLock.Lock()
+	delete(c.waiters, id)
+	c.leasesLock.Unlock()
+
+	cancelCtx, cancel := context.WithTimeout(c.ctx, submitTimeout)
+	defer cancel()
+
+	err = c.CancelLeaseRequest(cancelCtx, id)
+	if err != nil && status.Code(err) != codes.NotFound {
+		c.log.Println("Failed to cancel lease request", id, err)
+	}
+
+	return nil, ctx.Err()
+}
+
+// resolveWaiter hands a granted lease, or nil if the lease request expired,
+// to AcquireLease, by the lease request's original ID
+func (c *Client) resolveWaiter(leaseRequestID string, l *svc.Lease) {
+	c.leasesLock.Lock()
+	defer c.leasesLock.Unlock()
+
+	ch, ok := c.waiters[leaseRequestID]
+	if !ok {
+		return
+	}
+
+	delete(c.waiters, leaseRequestID)
+	ch <- l
+}
+
+func leaseRequestFromConfig(request config.LeaseRequest) (*svc.LeaseRequest, error) {
+	ttl, err := ttlFromConfig(request.TTL)
+	if err != nil {
+		return nil, err
+	}
+
+	var accessMode svc.LeaseAccessMode
+	switch request.AccessMode {
+	case "", "exclusive":
+		accessMode = svc.LeaseAccessMode_LEASEACCESSEXCLUSIVE
+	case "shared":
+		accessMode = svc.LeaseAccessMode_LEASEACCESSSHARED
+	default:
+		return nil, fmt.Errorf("invalid access mode %q", request.AccessMode)
+	}
+
+	return &svc.LeaseRequest{
+		Tag:              request.Tag,
+		AvailabilityZone: request.AvailabilityZone,
+		VolumeId:         request.VolumeID,
+		Priority:         int32(request.Priority),
+		Ttl:              ttl,
+		AccessMode:       accessMode,
+	}, nil
+}
+
+func ttlFromConfig(ttl string) (*durationpb.Duration, error) {
+	if ttl == "" {
+		return nil, nil
+	}
+
+	d, err := time.ParseDuration(ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	return ptypes.DurationProto(d), nil
+}
+
+// sendHeartbeats sends heartbeats on a regular basis until the client stops
+func (c *Client) sendHeartbeats() {
 	t := time.NewTicker(time.Millisecond * 500)
+	defer t.Stop()
 
 	for {
 		select {
+		case <-c.ctx.Done():
+			return
 		case <-t.C:
-			_, err := c.svcClient.Heartbeat(context.Background(), &svc.HeartbeatMessage{Id: c.ClientID})
-			if err != nil {
+			_, err := c.svcClient.Heartbeat(c.ctx, &svc.HeartbeatMessage{Id: c.ClientID})
+			if err == nil || c.ctx.Err() != nil {
+				continue
+			}
+
+			if sessionError(err) {
+				c.lostSession()
+				continue
+			}
+
+			c.log.Println(err)
+		}
+	}
+}
+
+// sendRenewals renews leases and lease requests before they expire, until
+// the client stops
+func (c *Client) sendRenewals() {
+	t := time.NewTicker(time.Millisecond * 500)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-t.C:
+			now := time.Now()
+			due := make(map[string]bool)
+
+			c.renewalsLock.Lock()
+			for id, r := range c.renewals {
+				if !r.renewAt.After(now) {
+					due[id] = r.lease
+				}
+			}
+			c.renewalsLock.Unlock()
+
+			for id, isLease := range due {
+				err := c.renew(id, isLease)
+				if err == nil || c.ctx.Err() != nil {
+					continue
+				}
+
+				// renewals resume once the client reconnects
+				if sessionError(err) {
+					c.lostSession()
+					continue
+				}
+
 				c.log.Println(err)
 			}
 		}
 	}
 }
 
-// WatchNotifications handles notifications and runs callback functions
-func (c *Client) WatchNotifications() {
+func (c *Client) renew(id string, isLease bool) error {
+	msg := &svc.RenewLeaseMessage{
+		ClientId: c.ClientID,
+		Id:       id,
+	}
+
+	var res *svc.RenewLeaseResponse
+	var err error
+	if isLease {
+		res, err = c.svcClient.RenewLease(c.ctx, msg)
+	} else {
+		res, err = c.svcClient.RenewLeaseRequest(c.ctx, msg)
+	}
+
+	if err != nil {
+		// the lease was released or the request was fulfilled
+		if status.Code(err) == codes.NotFound {
+			c.untrackRenewal(id)
+			return nil
+		}
+
+		return err
+	}
+
+	ttl, err := ptypes.Duration(res.Ttl)
+	if err != nil {
+		return err
+	}
+
+	c.trackRenewal(id, isLease, time.Now().Add(ttl/2))
+
+	return nil
+}
+
+func (c *Client) trackRenewal(id string, isLease bool, renewAt time.Time) {
+	c.renewalsLock.Lock()
+	defer c.renewalsLock.Unlock()
+
+	c.renewals[id] = &renewal{
+		lease:   isLease,
+		renewAt: renewAt,
+	}
+}
+
+func (c *Client) untrackRenewal(id string) {
+	c.renewalsLock.Lock()
+	defer c.renewalsLock.Unlock()
+
+	delete(c.renewals, id)
+}
+
+// trackRequest records a lease request or gang lease request until it is
+// granted
+func (c *Client) trackRequest(id string, o *outstanding) {
+	c.requestsLock.Lock()
+	defer c.requestsLock.Unlock()
+
+	// the lease may have been granted before the submission returned
+	var granted *svc.Lease
+	c.leasesLock.Lock()
+	for _, l := range c.leases {
+		if l.LeaseRequestId == id || l.GangId == id {
+			granted = l
+			break
+		}
+	}
+	c.leasesLock.Unlock()
+
+	if granted != nil {
+		c.resolveWaiter(o.origin, granted)
+		return
+	}
+
+	c.requests[id] = o
+}
+
+// untrackRequest forgets a lease request or gang lease request by its current
+// ID, returning its original ID
+func (c *Client) untrackRequest(id string) string {
+	c.requestsLock.Lock()
+	defer c.requestsLock.Unlock()
+
+	o, ok := c.requests[id]
+	if !ok {
+		return id
+	}
+
+	delete(c.requests, id)
+
+	return o.origin
+}
+
+// currentRequestID returns the ID a lease request or gang lease request has
+// on the server, given the ID it was first submitted with
+func (c *Client) currentRequestID(origin string) string {
+	c.requestsLock.Lock()
+	defer c.requestsLock.Unlock()
+
+	for id, o := range c.requests {
+		if o.origin == origin {
+			return id
+		}
+	}
+
+	return origin
+}
+
+// forgetLease stops tracking a lease the client no longer holds
+func (c *Client) forgetLease(leaseID string) {
+	c.leasesLock.Lock()
+	delete(c.leases, leaseID)
+	c.leasesLock.Unlock()
+
+	c.untrackRenewal(leaseID)
+}
+
+// watchNotifications handles notifications and runs callback functions until
+// the client stops. When the stream drops, it resumes after the last
+// notification received, backing off while the server is unreachable
+func (c *Client) watchNotifications() {
 	c.log.Println("Watching for notifications for client", c.ClientID)
 
-	stream, err := c.svcClient.WatchNotifications(context.Background(), &svc.NotificationWatchMessage{
-		Id: c.ClientID,
+	var cursor, epoch uint64
+	attempt := 0
+	for {
+		// a server that registered the client afresh numbers its
+		// notifications from scratch
+		if e := atomic.LoadUint64(&c.epoch); e != epoch {
+			epoch = e
+			cursor = 0
+		}
+
+		next, err := c.watchNotificationsAfter(cursor)
+
+		if c.ctx.Err() != nil {
+			return
+		}
+
+		if next != cursor {
+			attempt = 0
+		}
+		cursor = next
+
+		if status.Code(err) == codes.OutOfRange {
+			// missed notifications are gone, start over from the unacked ones
+			// and reconcile what they would have told
+			c.log.Println("Cannot resume notifications, resyncing:", err)
+			cursor = 0
+			c.lostSession()
+			continue
+		}
+
+		if sessionError(err) {
+			c.lostSession()
+		}
+
+		delay := reconnectDelay(attempt)
+		attempt++
+
+		c.log.Println("Notification stream lost, resuming after", cursor, "in", delay, err)
+
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+// watchNotificationsAfter handles notifications after a given sequence number
+// until the stream fails, returning the sequence number of the last one
+// received
+func (c *Client) watchNotificationsAfter(resumeAfter uint64) (uint64, error) {
+	stream, err := c.svcClient.WatchNotifications(c.ctx, &svc.NotificationWatchMessage{
+		Id:          c.ClientID,
+		ResumeAfter: resumeAfter,
 	})
 	if err != nil {
-		c.log.Fatalln(err)
+		return resumeAfter, err
 	}
 
+	cursor := resumeAfter
 	for {
 		msg, err := stream.Recv()
 		if err != nil {
-			c.log.Fatalln(err)
+			return cursor, err
 		}
 
 		c.log.Printf("Received notification: '%+v'\n", msg)
 
-		for _, f := range notifHandlers[msg.Type] {
-			err = f(c, msg)
-			if err != nil {
-				c.log.Println("Error executing callback:", err)
-				continue
-			}
+		if msg.Sequence > cursor {
+			cursor = msg.Sequence
+		}
+
+		if c.markHandled(msg.Id) {
+			c.dispatch(msg)
+		} else {
+			c.log.Println("Skipping redelivered notification", msg.Id)
 		}
 
-		_, err = c.svcClient.Acknowledge(context.Background(), &svc.Acknowledgement{
+		_, err = c.svcClient.Acknowledge(c.ctx, &svc.Acknowledgement{
 			Id: msg.Id,
 		})
 		if err != nil {
-			c.log.Fatalln(err)
+			return cursor, err
 		}
 		c.log.Println("Acknowledged", msg.Id)
 	}
 }
 
-// Stop stops a client
-func (c *Client) Stop() error {
-	c.svcClient.Deregister(context.Background(), &svc.DeregisterMessage{
-		Id: c.ClientID,
+// markHandled records a notification as handled, returning false if it
+// already was
+func (c *Client) markHandled(id string) bool {
+	c.handledLock.Lock()
+	defer c.handledLock.Unlock()
+
+	if c.handled[id] {
+		return false
+	}
+
+	c.handled[id] = true
+	c.handledOrder = append(c.handledOrder, id)
+
+	if len(c.handledOrder) > handledNotificationsSize {
+		delete(c.handled, c.handledOrder[0])
+		c.handledOrder = c.handledOrder[1:]
+	}
+
+	return true
+}
+
+// CancelLeaseRequest withdraws a pending lease request, or gang lease request
+// by its gang ID
+func (c *Client) CancelLeaseRequest(ctx context.Context, id string) error {
+	if c.svcClient == nil {
+		return ErrClientNotStarted
+	}
+
+	current := c.currentRequestID(id)
+
+	_, err := c.svcClient.CancelLeaseRequest(ctx, &svc.CancelLeaseRequestMessage{
+		ClientId:       c.ClientID,
+		LeaseRequestId: current,
 	})
-	c.conn.Close()
+	if err != nil {
+		return err
+	}
+
+	c.untrackRenewal(current)
+	c.untrackRequest(current)
+
 	return nil
 }
+
+// UpdateLeaseRequest replaces the volume selector and priority of a pending
+// lease request with those of a given request
+func (c *Client) UpdateLeaseRequest(ctx context.Context, id string, request *svc.LeaseRequest) error {
+	if c.svcClient == nil {
+		return ErrClientNotStarted
+	}
+
+	current := c.currentRequestID(id)
+
+	_, err := c.svcClient.UpdateLeaseRequest(ctx, &svc.UpdateLeaseRequestMessage{
+		ClientId:         c.ClientID,
+		LeaseRequestId:   current,
+		Tag:              request.Tag,
+		AvailabilityZone: request.AvailabilityZone,
+		VolumeId:         request.VolumeId,
+		Priority:         request.Priority,
+	})
+	if err != nil {
+		return err
+	}
+
+	// a resubmission asks for the updated volume
+	c.requestsLock.Lock()
+	if o, ok := c.requests[current]; ok && o.request != nil {
+		updated := proto.Clone(o.request).(*svc.LeaseRequest)
+		updated.Tag = request.Tag
+		updated.AvailabilityZone = request.AvailabilityZone
+		updated.VolumeId = request.VolumeId
+		updated.Priority = request.Priority
+		o.request = updated
+	}
+	c.requestsLock.Unlock()
+
+	return nil
+}
+
+// ReleaseLease gives a leased volume back to the server
+func (c *Client) ReleaseLease(ctx context.Context, leaseID string) error {
+	if c.svcClient == nil {
+		return ErrClientNotStarted
+	}
+
+	_, err := c.svcClient.ReleaseLease(ctx, &svc.ReleaseLeaseMessage{
+		ClientId: c.ClientID,
+		LeaseId:  leaseID,
+	})
+	if err != nil {
+		return err
+	}
+
+	c.forgetLease(leaseID)
+
+	return nil
+}
+
+// TransferLease hands a leased volume directly to another client
+func (c *Client) TransferLease(ctx context.Context, leaseID, targetClientID string) error {
+	if c.svcClient == nil {
+		return ErrClientNotStarted
+	}
+
+	_, err := c.svcClient.TransferLease(ctx, &svc.TransferLeaseMessage{
+		ClientId:       c.ClientID,
+		LeaseId:        leaseID,
+		TargetClientId: targetClientID,
+	})
+	if err != nil {
+		return err
+	}
+
+	c.forgetLease(leaseID)
+
+	return nil
+}
+
+// ListMyLeases returns the leases the server holds for the client
+func (c *Client) ListMyLeases(ctx context.Context) ([]*svc.Lease, error) {
+	if c.svcClient == nil {
+		return nil, ErrClientNotStarted
+	}
+
+	res, err := c.svcClient.ListClientLeases(ctx, &svc.ListClientLeasesMessage{
+		ClientId: c.ClientID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Leases, nil
+}
+
+// Stop stops the client's background loops, closing the Events channel, then
+// deregisters the client and closes its connection
+func (c *Client) Stop(ctx context.Context) error {
+	if c.conn == nil {
+		return ErrClientNotStarted
+	}
+
+	// only the first call stops the client, later ones wait for it
+	var err error
+	c.stopOnce.Do(func() {
+		c.cancel()
+		c.wg.Wait()
+		close(c.events)
+
+		_, err = c.svcClient.Deregister(ctx, &svc.DeregisterMessage{
+			Id: c.ClientID,
+		})
+
+		cerr := c.conn.Close()
+		if err == nil {
+			err = cerr
+		}
+	})
+
+	return err
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	svc "github.com/p0pr0ck5/volchestrator/svc"
+)
+
+// Event is a notification the client has handled, for applications embedding
+// the client to act on. The IDs are set when the notification carries them
+type Event struct {
+	Type svc.NotificationType
+
+	LeaseRequestID string
+	GangID         string
+	LeaseID        string
+
+	// Lease is set when a lease was granted, and GangLeases when a gang was
+	Lease      *svc.Lease
+	GangLeases []*svc.Lease
+
+	Notification *svc.Notification
+}
+
+// Events returns the channel handled notifications are sent on. Events are
+// dropped while the channel is full, and it is closed when the client stops
+func (c *Client) Events() <-chan Event {
+	return c.events
+}
+
+func newEvent(msg *svc.Notification) Event {
+	e := Event{
+		Type:         msg.Type,
+		Notification: msg,
+	}
+
+	switch p := msg.Payload.(type) {
+	case *svc.Notification_LeaseRequestAck:
+		e.LeaseRequestID = p.LeaseRequestAck.GetLeaseRequestId()
+		e.GangID = p.LeaseRequestAck.GetGangId()
+	case *svc.Notification_LeaseRequestExpired:
+		e.LeaseRequestID = p.LeaseRequestExpired.GetLeaseRequestId()
+		e.GangID = p.LeaseRequestExpired.GetGangId()
+	case *svc.Notification_LeaseAvailable:
+		e.LeaseRequestID = p.LeaseAvailable.GetLeaseRequestId()
+		e.GangID = p.LeaseAvailable.GetGangId()
+	case *svc.Notification_LeaseGranted:
+		e.Lease = p.LeaseGranted.GetLease()
+		e.GangLeases = p.LeaseGranted.GetGangLeases()
+		e.LeaseRequestID = e.Lease.GetLeaseRequestId()
+		e.GangID = e.Lease.GetGangId()
+		e.LeaseID = e.Lease.GetLeaseId()
+		if p.LeaseGranted.GetGangId() != "" {
+			e.GangID = p.LeaseGranted.GetGangId()
+		}
+	case *svc.Notification_LeaseRevoked:
+		e.LeaseID = p.LeaseRevoked.GetLeaseId()
+	case *svc.Notification_LeaseReleased:
+		e.LeaseID = p.LeaseReleased.GetLeaseId()
+	case *svc.Notification_LeaseTransferred:
+		e.LeaseID = p.LeaseTransferred.GetLeaseId()
+	case *svc.Notification_LeaseLifetimeWarning:
+		e.LeaseID = p.LeaseLifetimeWarning.GetLeaseId()
+	case *svc.Notification_LeaseExpiring:
+		e.LeaseID = p.LeaseExpiring.GetLeaseId()
+		e.LeaseRequestID = p.LeaseExpiring.GetLeaseRequestId()
+		e.GangID = p.LeaseExpiring.GetGangId()
+	}
+
+	return e
+}
+
+// publish sends an event for a handled notification without blocking
+func (c *Client) publish(msg *svc.Notification) {
+	select {
+	case c.events <- newEvent(msg):
+	default:
+		c.log.Println("Event buffer is full, dropping event for notification", msg.Id)
+	}
+}
@@ -0,0 +1,190 @@
+package client
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	svc "github.com/p0pr0ck5/volchestrator/svc"
+)
+
+// NotificationHandler is a function run for each notification of a type it
+// is registered for
+type NotificationHandler func(*Client, *svc.Notification) error
+
+// Handle registers a function to run for notifications of a given type. The
+// client's own handlers, which track leases and renew them, run first
+func (c *Client) Handle(t svc.NotificationType, h NotificationHandler) {
+	c.handlersLock.Lock()
+	defer c.handlersLock.Unlock()
+
+	c.handlers[t] = append(c.handlers[t], h)
+}
+
+// handle runs the handlers registered for a notification
+func (c *Client) handle(msg *svc.Notification) {
+	c.handlersLock.RLock()
+	handlers := c.handlers[msg.Type]
+	c.handlersLock.RUnlock()
+
+	for _, f := range handlers {
+		err := f(c, msg)
+		if err != nil {
+			c.log.Println("Error executing callback:", err)
+			continue
+		}
+	}
+}
+
+// dispatch runs the handlers for a notification, then sends it as an event
+func (c *Client) dispatch(msg *svc.Notification) {
+	c.handle(msg)
+	c.publish(msg)
+}
+
+func (c *Client) registerDefaultHandlers() {
+	c.Handle(svc.NotificationType_NOTIFICATIONLEASEREQUESTACK, func(client *Client, msg *svc.Notification) error {
+		p := msg.GetLeaseRequestAck()
+
+		// gang lease requests are renewed by their gang ID
+		id := p.GetLeaseRequestId()
+		if p.GetGangId() != "" {
+			id = p.GetGangId()
+		}
+
+		// renew right away to learn the request's TTL
+		client.trackRenewal(id, false, time.Now())
+		return nil
+	})
+
+	c.Handle(svc.NotificationType_NOTIFICATIONLEASEREQUESTEXPIRED, func(client *Client, msg *svc.Notification) error {
+		p := msg.GetLeaseRequestExpired()
+
+		id := p.GetLeaseRequestId()
+		if p.GetGangId() != "" {
+			id = p.GetGangId()
+		}
+
+		client.untrackRenewal(id)
+		origin := client.untrackRequest(id)
+		if p.GetGangId() == "" {
+			client.resolveWaiter(origin, nil)
+		}
+
+		client.log.Println("Lease request", id, "expired")
+		return nil
+	})
+
+	c.Handle(svc.NotificationType_NOTIFICATIONLEASEAVAILABLE, func(client *Client, msg *svc.Notification) error {
+		client.log.Println("Offered volumes", msg.GetLeaseAvailable().GetVolumeIds()) // acking the notification accepts the offer
+		return nil
+	})
+
+	c.Handle(svc.NotificationType_NOTIFICATIONLEASE, func(client *Client, msg *svc.Notification) error {
+		p := msg.GetLeaseGranted()
+
+		// gangs are granted all their leases at once
+		leases := p.GetGangLeases()
+		if p.GetGangId() == "" {
+			leases = []*svc.Lease{p.GetLease()}
+		}
+
+		for _, l := range leases {
+			if l == nil {
+				return fmt.Errorf("lease notification %s has no lease", msg.Id)
+			}
+
+			client.log.Println("Granted lease", l.LeaseId, "on volume", l.VolumeId)
+
+			expires, err := ptypes.Timestamp(l.Expires)
+			if err != nil {
+				return err
+			}
+
+			client.leasesLock.Lock()
+			client.leases[l.LeaseId] = l
+			client.leasesLock.Unlock()
+
+			if l.GangId != "" {
+				client.untrackRequest(l.GangId)
+			}
+
+			client.resolveWaiter(client.untrackRequest(l.LeaseRequestId), l)
+			client.trackRenewal(l.LeaseId, true, time.Now().Add(time.Until(expires)/2))
+		}
+
+		return nil
+	})
+
+	c.Handle(svc.NotificationType_NOTIFICATIONLEASEREVOKED, func(client *Client, msg *svc.Notification) error {
+		p := msg.GetLeaseRevoked()
+
+		gracePeriod, _ := ptypes.Duration(p.GetGracePeriod())
+		client.log.Println("Lease", p.GetLeaseId(), "is being revoked within", gracePeriod, "releasing it")
+
+		go func() {
+			err := client.ReleaseLease(client.ctx, p.GetLeaseId())
+			if err != nil {
+				client.log.Println(err)
+			}
+		}()
+
+		return nil
+	})
+
+	c.Handle(svc.NotificationType_NOTIFICATIONLEASERELEASED, func(client *Client, msg *svc.Notification) error {
+		p := msg.GetLeaseReleased()
+
+		client.log.Println("Lease", p.GetLeaseId(), "released:", p.GetReason())
+		client.forgetLease(p.GetLeaseId())
+
+		return nil
+	})
+
+	c.Handle(svc.NotificationType_NOTIFICATIONLEASETRANSFERRED, func(client *Client, msg *svc.Notification) error {
+		p := msg.GetLeaseTransferred()
+
+		client.log.Println("Lease", p.GetLeaseId(), "transferred to", p.GetTargetClientId())
+		client.forgetLease(p.GetLeaseId())
+
+		return nil
+	})
+
+	c.Handle(svc.NotificationType_NOTIFICATIONLEASEEXPIRING, func(client *Client, msg *svc.Notification) error {
+		p := msg.GetLeaseExpiring()
+
+		if p.GetLeaseId() != "" {
+			client.log.Println("Warning: lease", p.GetLeaseId(), "is about to expire, renewing it")
+			return client.renew(p.GetLeaseId(), true)
+		}
+
+		id := p.GetLeaseRequestId()
+		if p.GetGangId() != "" {
+			id = p.GetGangId()
+		}
+
+		client.log.Println("Warning: lease request", id, "is about to expire, renewing it")
+		return client.renew(id, false)
+	})
+
+	c.Handle(svc.NotificationType_NOTIFICATIONLEASELIFETIMEWARNING, func(client *Client, msg *svc.Notification) error {
+		p := msg.GetLeaseLifetimeWarning()
+
+		deadline, err := ptypes.Timestamp(p.GetDeadline())
+		if err != nil {
+			return err
+		}
+
+		client.log.Printf("Lease %s reaches its maximum lifetime at %s and will be released\n",
+			p.GetLeaseId(), deadline.Format(time.RFC3339))
+
+		client.leasesLock.Lock()
+		if l, ok := client.leases[p.GetLeaseId()]; ok {
+			l.Deadline = p.GetDeadline()
+		}
+		client.leasesLock.Unlock()
+
+		return nil
+	})
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"log"
+
+	"google.golang.org/grpc"
+
+	svc "github.com/p0pr0ck5/volchestrator/svc"
+)
+
+// DefaultEventBufferSize is how many events the Events channel holds when not
+// otherwise configured, after which events are dropped
+const DefaultEventBufferSize = 64
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithClientID sets the ID the client registers with, instead of a random one
+func WithClientID(id string) Option {
+	return func(c *Client) {
+		c.ClientID = id
+	}
+}
+
+// WithLogger sets the logger the client logs to
+func WithLogger(l *log.Logger) Option {
+	return func(c *Client) {
+		c.log = l
+	}
+}
+
+// WithDialOptions replaces the options used to dial the server, which
+// default to an insecure connection
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(c *Client) {
+		c.dialOptions = opts
+	}
+}
+
+// WithHandler registers a function to run for notifications of a given type
+func WithHandler(t svc.NotificationType, h NotificationHandler) Option {
+	return func(c *Client) {
+		c.Handle(t, h)
+	}
+}
+
+// WithEventBuffer sets how many events the Events channel holds
+func WithEventBuffer(size int) Option {
+	return func(c *Client) {
+		c.eventBufferSize = size
+	}
+}
@@ -0,0 +1,293 @@
+package client
+
+import (
+	"context"
+	"math/rand"
+	"sync/atomic"
+	"time"
+
+	"github.com/thanhpk/randstr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	svc "github.com/p0pr0ck5/volchestrator/svc"
+)
+
+// reconnectBackoff is the longest wait before the first reconnection
+// attempt, doubling with each failed attempt up to maxReconnectBackoff
+const reconnectBackoff = time.Millisecond * 500
+const maxReconnectBackoff = time.Second * 30
+
+// reconnectTimeout bounds each call made while reconnecting
+const reconnectTimeout = time.Second * 5
+
+// reconnectDelay returns how long to wait before a given reconnection
+// attempt. The delay is jittered so clients cut off by the same outage don't
+// all reconnect at once
+func reconnectDelay(attempt int) time.Duration {
+	d := reconnectBackoff << attempt
+	if d <= 0 || d > maxReconnectBackoff {
+		d = maxReconnectBackoff
+	}
+
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// sessionError reports whether an error means the server can't be reached,
+// or no longer knows the client
+func sessionError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.NotFound:
+		return true
+	}
+
+	return false
+}
+
+// lostSession wakes maintainSession to reconnect to the server
+func (c *Client) lostSession() {
+	select {
+	case c.sessionLost <- struct{}{}:
+	default:
+	}
+}
+
+// maintainSession reconnects to the server each time the session is lost,
+// until the client stops
+func (c *Client) maintainSession() {
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-c.sessionLost:
+		}
+
+		c.reconnect()
+	}
+}
+
+// reconnect registers the client again under the same ID, then resubmits its
+// outstanding lease requests and reconciles its leases with the server,
+// retrying with backoff until it succeeds or the client stops
+func (c *Client) reconnect() {
+	c.log.Println("Reconnecting client", c.ClientID)
+
+	for attempt := 0; ; attempt++ {
+		ctx, cancel := context.WithTimeout(c.ctx, reconnectTimeout)
+		fresh, err := c.register(ctx, true)
+		cancel()
+
+		if err == nil {
+			if fresh {
+				atomic.AddUint64(&c.epoch, 1)
+			}
+
+			err = c.resync()
+		}
+
+		if err == nil {
+			break
+		}
+
+		if c.ctx.Err() != nil {
+			return
+		}
+
+		delay := reconnectDelay(attempt)
+		c.log.Println("Failed to reconnect, retrying in", delay, err)
+
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+
+	// failures seen while reconnecting are resolved
+	select {
+	case <-c.sessionLost:
+	default:
+	}
+
+	c.log.Println("Reconnected client", c.ClientID)
+}
+
+// register registers the client, reporting whether the server registered it
+// afresh rather than still holding its session. Only a reconnecting client may
+// find its ID already registered, otherwise another process is using it
+func (c *Client) register(ctx context.Context, reconnecting bool) (bool, error) {
+	_, err := c.svcClient.Register(ctx, &svc.RegisterMessage{
+		Id: c.ClientID,
+	})
+	if reconnecting && status.Code(err) == codes.AlreadyExists {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// resync brings the client's view of its leases and lease requests in line
+// with the server's
+func (c *Client) resync() error {
+	ctx, cancel := context.WithTimeout(c.ctx, reconnectTimeout)
+	leases, err := c.ListMyLeases(ctx)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	c.reconcileLeases(leases)
+
+	return c.resubmitLeaseRequests(leases)
+}
+
+// reconcileLeases forgets the leases the server no longer holds for the
+// client, and takes up those it doesn't know of, e.g. granted while it was
+// disconnected or held before the process restarted. Both are
+// handled as if the server had sent the notification, so handlers and
+// Events see them
+func (c *Client) reconcileLeases(held []*svc.Lease) {
+	server := make(map[string]*svc.Lease)
+	for _, l := range held {
+		server[l.LeaseId] = l
+	}
+
+	lost := []string{}
+	granted := []*svc.Lease{}
+
+	c.leasesLock.Lock()
+	for id := range c.leases {
+		if _, ok := server[id]; !ok {
+			lost = append(lost, id)
+		}
+	}
+
+	for id, l := range server {
+		if _, ok := c.leases[id]; !ok && l.Status == svc.LeaseStatus_LEASEASSIGNED {
+			granted = append(granted, l)
+		}
+	}
+	c.leasesLock.Unlock()
+
+	for _, id := range lost {
+		c.log.Println("Lease", id, "is no longer held")
+
+		c.dispatch(&svc.Notification{
+			Type: svc.NotificationType_NOTIFICATIONLEASERELEASED,
+			Payload: &svc.Notification_LeaseReleased{
+				LeaseReleased: &svc.LeaseReleasedPayload{
+					LeaseId: id,
+					Reason:  "not held by the server after reconnecting",
+				},
+			},
+		})
+	}
+
+	for _, l := range granted {
+		c.log.Println("Lease", l.LeaseId, "is held by the server, taking it up")
+
+		c.dispatch(&svc.Notification{
+			Type: svc.NotificationType_NOTIFICATIONLEASE,
+			Payload: &svc.Notification_LeaseGranted{
+				LeaseGranted: &svc.LeaseGrantedPayload{
+					Lease: l,
+				},
+			},
+		})
+	}
+
+	// the leases still held may have missed renewals while disconnected
+	for id := range server {
+		c.leasesLock.Lock()
+		_, ok := c.leases[id]
+		c.leasesLock.Unlock()
+
+		if ok {
+			c.trackRenewal(id, true, time.Now())
+		}
+	}
+}
+
+// resubmitLeaseRequests submits again the outstanding lease requests and gang
+// lease requests the server no longer has. Those granted while disconnected
+// were taken up by reconcileLeases
+func (c *Client) resubmitLeaseRequests(held []*svc.Lease) error {
+	granted := make(map[string]bool)
+	for _, l := range held {
+		granted[l.LeaseRequestId] = true
+		if l.GangId != "" {
+			granted[l.GangId] = true
+		}
+	}
+
+	c.requestsLock.Lock()
+	requests := make(map[string]*outstanding)
+	for id, o := range c.requests {
+		requests[id] = o
+	}
+	c.requestsLock.Unlock()
+
+	for id, o := range requests {
+		if granted[id] {
+			c.untrackRequest(id)
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(c.ctx, reconnectTimeout)
+		_, err := c.svcClient.RenewLeaseRequest(ctx, &svc.RenewLeaseMessage{
+			ClientId: c.ClientID,
+			Id:       id,
+		})
+		cancel()
+
+		if err == nil {
+			// renew right away to learn the request's TTL
+			c.trackRenewal(id, false, time.Now())
+			continue
+		}
+
+		if status.Code(err) != codes.NotFound {
+			return err
+		}
+
+		newID, err := c.resubmit(o)
+		if err != nil {
+			// the request itself may no longer be valid, e.g. if the volume
+			// it names is gone, which retrying won't fix
+			if code := status.Code(err); code == codes.Unavailable || code == codes.DeadlineExceeded {
+				return err
+			}
+
+			c.log.Println("Failed to resubmit lease request", o.origin, err)
+			c.untrackRenewal(id)
+			c.untrackRequest(id)
+			continue
+		}
+
+		c.untrackRenewal(id)
+		c.untrackRequest(id)
+		c.trackRequest(newID, o)
+
+		c.log.Println("Resubmitted lease request", o.origin, "as", newID)
+	}
+
+	return nil
+}
+
+// resubmit submits an outstanding lease request or gang lease request again,
+// returning its new ID
+func (c *Client) resubmit(o *outstanding) (string, error) {
+	// the old idempotency key may still map to the lost request or gang
+	if o.gang != nil {
+		gang := proto.Clone(o.gang).(*svc.GangLeaseRequest)
+		gang.IdempotencyKey = randstr.Hex(16)
+
+		return c.submitGangLeaseRequest(c.ctx, gang)
+	}
+
+	request := proto.Clone(o.request).(*svc.LeaseRequest)
+	request.IdempotencyKey = randstr.Hex(16)
+
+	return c.submitLeaseRequest(c.ctx, request)
+}
@@ -17,6 +17,7 @@ limitations under the License.
 */
 
 import (
+	"context"
 	"log"
 	"os"
 	"os/signal"
@@ -43,7 +44,10 @@ func clientRun(cmd *cobra.Command, args []string) {
 		log.Fatalf("failed to create a new client: %s", err)
 	}
 
-	c.Run()
+	err = c.Run()
+	if err != nil {
+		log.Fatalf("failed to start the client: %s", err)
+	}
 
 	sigs := make(chan os.Signal, 1)
 	done := make(chan struct{})
@@ -52,8 +56,14 @@ func clientRun(cmd *cobra.Command, args []string) {
 
 	<-sigs
 
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
 	go func() {
-		c.Stop()
+		err := c.Stop(ctx)
+		if err != nil {
+			log.Println(err)
+		}
 		close(done)
 	}()
 
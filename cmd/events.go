@@ -0,0 +1,110 @@
+package cmd
+
+/*
+Copyright © 2020 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/p0pr0ck5/volchestrator/server"
+	svc "github.com/p0pr0ck5/volchestrator/svc"
+)
+
+var eventsServerAddress string
+var eventsTypes []string
+var eventsClientID string
+var eventsVolumeID string
+
+func eventsRun(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, eventsServerAddress, grpc.WithBlock(), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("failed to dial: %s", err)
+	}
+	defer conn.Close()
+
+	c := svc.NewVolchestratorAdminClient(conn)
+
+	f := &svc.EventFilter{
+		ClientId: eventsClientID,
+		VolumeId: eventsVolumeID,
+	}
+
+	for _, name := range eventsTypes {
+		t, err := server.ParseEventType(name)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		f.Types = append(f.Types, svc.EventType(t))
+	}
+
+	stream, err := c.WatchEvents(context.Background(), f)
+	if err != nil {
+		log.Fatalf("failed to watch events: %s", err)
+	}
+
+	for {
+		e, err := stream.Recv()
+		if err != nil {
+			log.Fatalf("event stream failed: %s", err)
+		}
+
+		t, _ := ptypes.Timestamp(e.Time)
+
+		line := fmt.Sprintf("%s  %s", t.Format(time.RFC3339), server.EventType(e.Type))
+		for _, kv := range [][2]string{
+			{"client", e.ClientId},
+			{"volume", e.VolumeId},
+			{"request", e.LeaseRequestId},
+			{"lease", e.LeaseId},
+			{"message", e.Message},
+		} {
+			if kv[1] != "" {
+				line += " " + kv[0] + "=" + kv[1]
+			}
+		}
+
+		fmt.Println(line)
+	}
+}
+
+// eventsCmd represents the events command
+var eventsCmd = &cobra.Command{
+	Use:   "events",
+	Short: "Stream server events",
+	Long:  `Streams what the server is doing as it happens, such as clients coming and going, volume changes and lease lifecycle events, optionally narrowed to some event types, a client or a volume`,
+	Args:  cobra.NoArgs,
+	Run:   eventsRun,
+}
+
+func init() {
+	rootCmd.AddCommand(eventsCmd)
+
+	eventsCmd.Flags().StringVarP(&eventsServerAddress, "server-address", "s", "127.0.0.1:50051", "Address of the volchestrator server")
+	eventsCmd.Flags().StringSliceVarP(&eventsTypes, "type", "t", nil, "Only show events of given types, e.g. lease_assigned,client_dead")
+	eventsCmd.Flags().StringVar(&eventsClientID, "client", "", "Only show events for a given client")
+	eventsCmd.Flags().StringVar(&eventsVolumeID, "volume", "", "Only show events for a given volume")
+}
@@ -0,0 +1,99 @@
+package cmd
+
+/*
+Copyright © 2020 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	svc "github.com/p0pr0ck5/volchestrator/svc"
+)
+
+var explainServerAddress string
+
+func explainRun(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, explainServerAddress, grpc.WithBlock(), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("failed to dial: %s", err)
+	}
+	defer conn.Close()
+
+	c := svc.NewVolchestratorAdminClient(conn)
+
+	res, err := c.ExplainLeaseRequest(ctx, &svc.LeaseRequestID{
+		Id: args[0],
+	})
+	if err != nil {
+		log.Fatalf("failed to explain lease request: %s", err)
+	}
+
+	if len(res.Members) == 0 {
+		printExplanation(res)
+		return
+	}
+
+	fmt.Println("Gang", res.GangId)
+
+	for _, member := range res.Members {
+		printExplanation(member)
+	}
+}
+
+// printExplanation prints the reasons each volume has not been leased to a
+// lease request
+func printExplanation(res *svc.LeaseRequestExplanation) {
+	if res.GangId != "" {
+		fmt.Println("Lease request", res.LeaseRequestId, "in gang", res.GangId)
+	} else {
+		fmt.Println("Lease request", res.LeaseRequestId)
+	}
+
+	for _, v := range res.Volumes {
+		if v.Eligible {
+			fmt.Printf("  %s: eligible\n", v.VolumeId)
+			continue
+		}
+
+		fmt.Printf("  %s:\n", v.VolumeId)
+		for _, reason := range v.Reasons {
+			fmt.Printf("    - %s\n", reason)
+		}
+	}
+}
+
+// explainCmd represents the explain command
+var explainCmd = &cobra.Command{
+	Use:   "explain [lease request or gang id]",
+	Short: "Explain why a lease request has not been fulfilled",
+	Long:  `Evaluates a pending lease request, or each lease request of a gang, against every volume and reports why each volume has not been leased to it`,
+	Args:  cobra.ExactArgs(1),
+	Run:   explainRun,
+}
+
+func init() {
+	rootCmd.AddCommand(explainCmd)
+
+	explainCmd.Flags().StringVarP(&explainServerAddress, "server-address", "s", "127.0.0.1:50051", "Address of the volchestrator server")
+}
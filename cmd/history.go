@@ -0,0 +1,105 @@
+package cmd
+
+/*
+Copyright © 2020 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	svc "github.com/p0pr0ck5/volchestrator/svc"
+)
+
+var historyServerAddress string
+var historyVolumeID string
+var historyClientID string
+var historyLeaseID string
+var historySince time.Duration
+
+func historyRun(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, historyServerAddress, grpc.WithBlock(), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("failed to dial: %s", err)
+	}
+	defer conn.Close()
+
+	c := svc.NewVolchestratorAdminClient(conn)
+
+	q := &svc.HistoryQuery{
+		VolumeId: historyVolumeID,
+		ClientId: historyClientID,
+		LeaseId:  historyLeaseID,
+	}
+
+	if historySince > 0 {
+		q.Since, _ = ptypes.TimestampProto(time.Now().Add(-historySince))
+	}
+
+	res, err := c.ListLeaseHistory(ctx, q)
+	if err != nil {
+		log.Fatalf("failed to list lease history: %s", err)
+	}
+
+	for _, e := range res.Events {
+		t, _ := ptypes.Timestamp(e.Time)
+
+		id := e.LeaseRequestId
+		if e.LeaseId != "" {
+			id = e.LeaseId
+		}
+
+		state := strings.ToLower(strings.TrimPrefix(e.State.String(), "HISTORY"))
+
+		line := fmt.Sprintf("%s  %-10s  %s  client=%s", t.Format(time.RFC3339), state, id, e.ClientId)
+		if e.VolumeId != "" {
+			line += " volume=" + e.VolumeId
+		}
+		if e.Reason != "" {
+			line += " reason=" + e.Reason
+		}
+
+		fmt.Println(line)
+	}
+}
+
+// historyCmd represents the history command
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Show the lifecycle history of leases and lease requests",
+	Long:  `Lists the recorded state transitions of leases and lease requests, oldest first, optionally narrowed to a volume, client, lease or recent time window`,
+	Args:  cobra.NoArgs,
+	Run:   historyRun,
+}
+
+func init() {
+	rootCmd.AddCommand(historyCmd)
+
+	historyCmd.Flags().StringVarP(&historyServerAddress, "server-address", "s", "127.0.0.1:50051", "Address of the volchestrator server")
+	historyCmd.Flags().StringVar(&historyVolumeID, "volume", "", "Only show history for a given volume")
+	historyCmd.Flags().StringVar(&historyClientID, "client", "", "Only show history for a given client")
+	historyCmd.Flags().StringVar(&historyLeaseID, "lease", "", "Only show history for a given lease or lease request")
+	historyCmd.Flags().DurationVar(&historySince, "since", 0, "Only show history from a given duration ago")
+}
@@ -0,0 +1,69 @@
+package cmd
+
+/*
+Copyright © 2020 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	svc "github.com/p0pr0ck5/volchestrator/svc"
+)
+
+var notificationsServerAddress string
+
+func notificationsRun(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, notificationsServerAddress, grpc.WithBlock(), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("failed to dial: %s", err)
+	}
+	defer conn.Close()
+
+	c := svc.NewVolchestratorAdminClient(conn)
+
+	res, err := c.ListNotificationStats(ctx, &svc.Empty{})
+	if err != nil {
+		log.Fatalf("failed to list notification stats: %s", err)
+	}
+
+	for _, s := range res.Stats {
+		fmt.Printf("%s  pending=%d written=%d delivered=%d redelivered=%d dropped=%d coalesced=%d streams_dropped=%d\n",
+			s.ClientId, s.Pending, s.Written, s.Delivered, s.Redelivered, s.Dropped, s.Coalesced, s.StreamsDropped)
+	}
+}
+
+// notificationsCmd represents the notifications command
+var notificationsCmd = &cobra.Command{
+	Use:   "notifications",
+	Short: "Show notification delivery stats per client",
+	Long:  `Lists how many notifications each client has pending, and how many were delivered, redelivered, dropped or coalesced`,
+	Args:  cobra.NoArgs,
+	Run:   notificationsRun,
+}
+
+func init() {
+	rootCmd.AddCommand(notificationsCmd)
+
+	notificationsCmd.Flags().StringVarP(&notificationsServerAddress, "server-address", "s", "127.0.0.1:50051", "Address of the volchestrator server")
+}
@@ -0,0 +1,73 @@
+package cmd
+
+/*
+Copyright © 2020 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+
+	"github.com/spf13/cobra"
+
+	"github.com/p0pr0ck5/volchestrator/server/webhook"
+)
+
+var webhookReceiverAddress string
+var webhookReceiverSecret string
+
+func webhookReceiverRun(cmd *cobra.Command, args []string) {
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if webhookReceiverSecret != "" {
+			timestamp := r.Header.Get(webhook.TimestampHeader)
+			signature := r.Header.Get(webhook.SignatureHeader)
+
+			if !webhook.Verify(webhookReceiverSecret, timestamp, body, signature) {
+				log.Printf("Rejecting delivery %s with a bad signature", r.Header.Get(webhook.DeliveryHeader))
+				http.Error(w, "bad signature", http.StatusUnauthorized)
+				return
+			}
+		}
+
+		fmt.Printf("%s %s %s\n", r.Header.Get(webhook.DeliveryHeader), r.Header.Get(webhook.EventHeader), body)
+	})
+
+	log.Println("Receiving webhooks at", webhookReceiverAddress)
+	log.Fatal(http.ListenAndServe(webhookReceiverAddress, nil))
+}
+
+// webhookReceiverCmd represents the webhook-receiver command
+var webhookReceiverCmd = &cobra.Command{
+	Use:   "webhook-receiver",
+	Short: "Run a local server that prints webhook deliveries",
+	Long:  `Runs an HTTP server that prints the webhook deliveries it receives, verifying their signatures when given the shared secret. Point a webhook in test mode at it to try out a webhook config`,
+	Args:  cobra.NoArgs,
+	Run:   webhookReceiverRun,
+}
+
+func init() {
+	rootCmd.AddCommand(webhookReceiverCmd)
+
+	webhookReceiverCmd.Flags().StringVarP(&webhookReceiverAddress, "listen-address", "l", "127.0.0.1:8080", "Address to listen on")
+	webhookReceiverCmd.Flags().StringVar(&webhookReceiverSecret, "secret", "", "Shared secret to verify signatures with")
+}
@@ -2,8 +2,39 @@ package config
 
 // ServerConfig is the overarching configuration for 'volchestrator server'
 type ServerConfig struct {
-	Listen  ListenConfig  `hcl:"listen,block"`
-	Backend BackendConfig `hcl:"backend,block"`
+	Listen     ListenConfig      `hcl:"listen,block"`
+	Backend    BackendConfig     `hcl:"backend,block"`
+	Quota      *QuotaConfig      `hcl:"quota,block"`
+	Preemption *PreemptionConfig `hcl:"preemption,block"`
+	LeaseTTL   *LeaseTTLConfig   `hcl:"lease_ttl,block"`
+
+	MaxLeaseLifetime *MaxLeaseLifetimeConfig `hcl:"max_lease_lifetime,block"`
+
+	// DeadClientGracePeriod is how long leases of dead clients are held
+	// before they are released
+	DeadClientGracePeriod string `hcl:"dead_client_grace_period,optional"`
+
+	// ExpiryWarningFraction is the fraction of their TTL left when clients
+	// are warned that a lease or lease request is about to expire
+	ExpiryWarningFraction float64 `hcl:"expiry_warning_fraction,optional"`
+
+	Webhooks []WebhookConfig `hcl:"webhook,block"`
+}
+
+// WebhookConfig sends lease and volume lifecycle events to an HTTP endpoint.
+// Events are event type names such as lease_assigned, and default to every
+// lease and volume lifecycle event. Pending deliveries are kept in QueuePath,
+// which defaults to webhook-<name>.json in the working directory. TestMode
+// allows plain HTTP to a local server and sends a ping on startup
+type WebhookConfig struct {
+	Name        string   `hcl:"name,label"`
+	URL         string   `hcl:"url"`
+	Events      []string `hcl:"events,optional"`
+	Secret      string   `hcl:"secret,optional"`
+	QueuePath   string   `hcl:"queue_path,optional"`
+	QueueSize   int      `hcl:"queue_size,optional"`
+	MaxAttempts int      `hcl:"max_attempts,optional"`
+	TestMode    bool     `hcl:"test_mode,optional"`
 }
 
 // ListenConfig specifies how the server should listen for gRPC requests
@@ -14,17 +45,92 @@ type ListenConfig struct {
 // BackendConfig specifies how to store data in the backend
 type BackendConfig struct {
 	Type string `hcl:"type,label"`
+
+	// NotificationBufferSize bounds the unacked notifications held per client,
+	// NotificationOverflowPolicy is one of drop_stream, coalesce or
+	// mark_unhealthy and decides what happens once a buffer is full
+	NotificationBufferSize     int    `hcl:"notification_buffer_size,optional"`
+	NotificationOverflowPolicy string `hcl:"notification_overflow_policy,optional"`
+
+	// HistorySize bounds the lease history events kept, the oldest being
+	// dropped past that
+	HistorySize int `hcl:"history_size,optional"`
+}
+
+// QuotaConfig limits the number of concurrent leases and pending lease requests
+// each client may hold, overall and within a given tag or availability zone.
+// A zero value means no limit
+type QuotaConfig struct {
+	MaxLeases          int                 `hcl:"max_leases,optional"`
+	MaxPendingRequests int                 `hcl:"max_pending_requests,optional"`
+	Tags               []ScopedQuotaConfig `hcl:"tag,block"`
+	AvailabilityZones  []ScopedQuotaConfig `hcl:"az,block"`
+}
+
+// ScopedQuotaConfig limits leases and pending lease requests for a single tag
+// or availability zone
+type ScopedQuotaConfig struct {
+	Name               string `hcl:"name,label"`
+	MaxLeases          int    `hcl:"max_leases,optional"`
+	MaxPendingRequests int    `hcl:"max_pending_requests,optional"`
+}
+
+// PreemptionConfig specifies when leases are revoked in favor of higher priority
+// lease requests
+type PreemptionConfig struct {
+	// Margin is how much a request's priority must exceed a holder's to preempt
+	// it, and must be positive
+	Margin int `hcl:"margin"`
+
+	// GracePeriod is how long a holder has to release a lease voluntarily, and
+	// must be positive
+	GracePeriod string `hcl:"grace_period"`
+}
+
+// LeaseTTLConfig bounds the TTLs clients may request for leases and lease requests
+type LeaseTTLConfig struct {
+	Default string `hcl:"default,optional"`
+	Min     string `hcl:"min,optional"`
+	Max     string `hcl:"max,optional"`
+}
+
+// MaxLeaseLifetimeConfig limits how long a client may hold a volume, regardless
+// of renewals. Holders are warned at each of the given durations before the limit
+type MaxLeaseLifetimeConfig struct {
+	Lifetime string                         `hcl:"lifetime,optional"`
+	Warnings []string                       `hcl:"warnings,optional"`
+	Tags     []ScopedMaxLeaseLifetimeConfig `hcl:"tag,block"`
+}
+
+// ScopedMaxLeaseLifetimeConfig limits how long a client may hold a volume with
+// a given tag
+type ScopedMaxLeaseLifetimeConfig struct {
+	Name     string `hcl:"name,label"`
+	Lifetime string `hcl:"lifetime"`
 }
 
 // ClientConfig is the overarching configuration for 'volchestrator client'
 type ClientConfig struct {
-	ServerAddress string         `hcl:"server_address,optional"`
-	ClientID      string         `hcl:"client_id,optional"`
-	LeaseRequests []LeaseRequest `hcl:"lease_request,block"`
+	ServerAddress     string             `hcl:"server_address,optional"`
+	ClientID          string             `hcl:"client_id,optional"`
+	LeaseRequests     []LeaseRequest     `hcl:"lease_request,block"`
+	GangLeaseRequests []GangLeaseRequest `hcl:"gang_lease_request,block"`
 }
 
 // LeaseRequest defines a configuration for a client's desire to lease a volume
 type LeaseRequest struct {
-	Tag              string `hcl:"tag"`
-	AvailabilityZone string `hcl:"az"`
+	Tag              string `hcl:"tag,optional"`
+	AvailabilityZone string `hcl:"az,optional"`
+	VolumeID         string `hcl:"volume_id,optional"`
+	Priority         int    `hcl:"priority,optional"`
+	TTL              string `hcl:"ttl,optional"`
+
+	// AccessMode is either "exclusive" (the default) or "shared"
+	AccessMode string `hcl:"access_mode,optional"`
+}
+
+// GangLeaseRequest defines a set of volumes a client needs to lease together
+type GangLeaseRequest struct {
+	LeaseRequests []LeaseRequest `hcl:"lease_request,block"`
+	TTL           string         `hcl:"ttl,optional"`
 }
@@ -3,4 +3,5 @@ server_address = "127.0.0.1:50051"
 lease_request {
   tag = "foo"
   az  = "us-west-2a"
+  ttl = "30s"
 }
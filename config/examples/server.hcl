@@ -2,4 +2,51 @@ listen {
   address = "127.0.0.1:50051"
 }
 
-backend "memory" {}
+backend "memory" {
+  notification_buffer_size     = 128
+  notification_overflow_policy = "drop_stream"
+  history_size                 = 10000
+}
+
+dead_client_grace_period = "30s"
+
+expiry_warning_fraction = 0.25
+
+quota {
+  max_leases           = 8
+  max_pending_requests = 16
+
+  tag "foo" {
+    max_leases = 4
+  }
+
+  az "us-west-2a" {
+    max_pending_requests = 8
+  }
+}
+
+preemption {
+  margin       = 10
+  grace_period = "30s"
+}
+
+lease_ttl {
+  default = "15s"
+  min     = "5s"
+  max     = "5m"
+}
+
+max_lease_lifetime {
+  lifetime = "8h"
+  warnings = ["1h", "10m"]
+
+  tag "compliance" {
+    lifetime = "2h"
+  }
+}
+
+# webhook "inventory" {
+#   url    = "https://inventory.example.com/volchestrator"
+#   events = ["lease_assigned", "lease_released", "volume_added", "volume_deleted"]
+#   secret = "changeme"
+# }
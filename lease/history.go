@@ -0,0 +1,115 @@
+package lease
+
+import (
+	"time"
+)
+
+// HistoryState is a step in the lifecycle of a lease or lease request
+type HistoryState int
+
+const (
+	// HistoryStateUnknown is a base value
+	HistoryStateUnknown HistoryState = iota
+
+	// HistoryStateRequested indicates a lease request was submitted
+	HistoryStateRequested
+
+	// HistoryStateOffered indicates a volume was offered to a lease request
+	HistoryStateOffered
+
+	// HistoryStateAcked indicates the client accepted an offered volume
+	HistoryStateAcked
+
+	// HistoryStateAssigning indicates a volume is being associated with a client
+	HistoryStateAssigning
+
+	// HistoryStateAssigned indicates a client holds a lease
+	HistoryStateAssigned
+
+	// HistoryStateReleasing indicates a volume is being disassociated from a client
+	HistoryStateReleasing
+
+	// HistoryStateReleased indicates a lease was removed
+	HistoryStateReleased
+
+	// HistoryStateExpired indicates a lease or lease request was not renewed in time
+	HistoryStateExpired
+
+	// HistoryStateFailed indicates a lease or lease request could not progress
+	HistoryStateFailed
+
+	// HistoryStateUpdated indicates a client changed a pending lease request
+	HistoryStateUpdated
+
+	// HistoryStateCancelled indicates a client withdrew a pending lease request
+	HistoryStateCancelled
+)
+
+// HistoryEvent records a single state transition of a lease or lease request
+type HistoryEvent struct {
+	Time           time.Time
+	LeaseRequestID string
+	LeaseID        string
+	ClientID       string
+	VolumeID       string
+	State          HistoryState
+	Reason         string
+}
+
+// HistoryFilterFunc is a function to filter a list of HistoryEvents based on a given condition
+type HistoryFilterFunc func(HistoryEvent) bool
+
+// HistoryFilterAll returns all HistoryEvents
+func HistoryFilterAll(e HistoryEvent) bool {
+	return true
+}
+
+// HistoryFilterByVolume returns all HistoryEvents for a given volume
+func HistoryFilterByVolume(id string) HistoryFilterFunc {
+	return func(e HistoryEvent) bool {
+		return e.VolumeID == id
+	}
+}
+
+// HistoryFilterByClient returns all HistoryEvents for a given client
+func HistoryFilterByClient(id string) HistoryFilterFunc {
+	return func(e HistoryEvent) bool {
+		return e.ClientID == id
+	}
+}
+
+// HistoryFilterByLease returns all HistoryEvents for a given lease or lease request
+func HistoryFilterByLease(id string) HistoryFilterFunc {
+	return func(e HistoryEvent) bool {
+		return e.LeaseID == id || e.LeaseRequestID == id
+	}
+}
+
+// HistoryFilterByTime returns all HistoryEvents within a given time range. A zero
+// since or until leaves that end of the range open
+func HistoryFilterByTime(since, until time.Time) HistoryFilterFunc {
+	return func(e HistoryEvent) bool {
+		if !since.IsZero() && e.Time.Before(since) {
+			return false
+		}
+
+		if !until.IsZero() && e.Time.After(until) {
+			return false
+		}
+
+		return true
+	}
+}
+
+// HistoryFilterAnd returns all HistoryEvents matching every given filter
+func HistoryFilterAnd(filters ...HistoryFilterFunc) HistoryFilterFunc {
+	return func(e HistoryEvent) bool {
+		for _, f := range filters {
+			if !f(e) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
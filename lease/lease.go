@@ -2,19 +2,46 @@ package lease
 
 import "time"
 
-// DefaultLeaseTTL is the length LeaseRequests can stay alive before they need to be replaced
+// DefaultLeaseTTL is the length LeaseRequests and Leases can stay alive before they need to be
+// renewed, when the client does not request a TTL
 const DefaultLeaseTTL = time.Duration(15) * time.Second // 60 seconds
 
 // LeaseAvailableAckTTL defines how long to wait for a client to ack a LeaseAvailable notification
 const LeaseAvailableAckTTL = time.Duration(5) * time.Second // 5 seconds
 
+// AccessMode describes how the holder of a lease may use a volume
+type AccessMode int
+
+const (
+	// AccessModeExclusive grants a single client read-write access to a volume
+	AccessModeExclusive AccessMode = iota
+
+	// AccessModeShared grants read-only access to a volume, alongside other shared leases
+	AccessModeShared
+)
+
 // LeaseRequest represets a client's desire to lease a given volume
 type LeaseRequest struct {
 	LeaseRequestID         string
 	ClientID               string
 	VolumeTag              string
 	VolumeAvailabilityZone string
+	VolumeID               string
 	Expires                time.Time
+	TTL                    time.Duration
+	Priority               int
+	AccessMode             AccessMode
+
+	// GangID groups LeaseRequests that must be satisfied together,
+	// it is empty for standalone requests
+	GangID string
+
+	// WarnedExpires is the expiry the client was last warned about
+	WarnedExpires time.Time
+
+	// Revision counts the times the client updated the request, so the
+	// scheduler can tell whether the request it offered is still current
+	Revision int
 }
 
 // LeaseRequestFilterFunc is a function to filter a list of LeaseRequests
@@ -33,6 +60,15 @@ func LeaseRequestFilterByClient(id string) LeaseRequestFilterFunc {
 	}
 }
 
+// LeaseRequestFilterByGang returns all LeaseRequests belonging to a given gang
+func LeaseRequestFilterByGang(id string) LeaseRequestFilterFunc {
+	return func(l LeaseRequest) bool {
+		return l.GangID == id
+	}
+}
+
+// LeaseStatus is a step in the lifecycle of a Lease. Leases move between
+// statuses with Transition
 type LeaseStatus int
 
 const (
@@ -43,15 +79,43 @@ const (
 	LeaseStatusAssigned
 
 	LeaseStatusReleasing
+
+	// LeaseStatusOrphaned indicates the client holding the lease is dead, and
+	// the lease is being held for it in case it comes back
+	LeaseStatusOrphaned
+
+	// LeaseStatusTransferring indicates the lease is being handed from one client to another
+	LeaseStatusTransferring
 )
 
 // Lease represents a lease of a volume to a client, for a given period of time
 type Lease struct {
-	LeaseID  string
-	ClientID string
-	VolumeID string
-	Expires  time.Time
-	Status   LeaseStatus
+	LeaseID        string
+	LeaseRequestID string
+	ClientID       string
+	VolumeID       string
+	Expires        time.Time
+	TTL            time.Duration
+	Status         LeaseStatus
+	GangID         string
+	Priority       int
+	AccessMode     AccessMode
+
+	// FencingToken increases monotonically for each lease of a volume, so that
+	// operations from holders of older leases can be rejected
+	FencingToken uint64
+
+	// Acquired is when the current client was given the volume. Deadline is
+	// when the lease is released regardless of renewals, and is zero if the
+	// lease has no maximum lifetime
+	Acquired time.Time
+	Deadline time.Time
+
+	// LifetimeWarnings is the number of maximum lifetime warnings sent
+	LifetimeWarnings int
+
+	// WarnedExpires is the expiry the client was last warned about
+	WarnedExpires time.Time
 }
 
 // LeaseFilterFunc is a function to filter a list of Leases based on a given condition
@@ -68,3 +132,17 @@ func LeaseFilterByClient(id string) LeaseFilterFunc {
 		return l.ClientID == id
 	}
 }
+
+// LeaseFilterByGang returns all Leases belonging to a given gang
+func LeaseFilterByGang(id string) LeaseFilterFunc {
+	return func(l Lease) bool {
+		return l.GangID == id
+	}
+}
+
+// LeaseFilterByVolume returns all Leases for a given volume
+func LeaseFilterByVolume(id string) LeaseFilterFunc {
+	return func(l Lease) bool {
+		return l.VolumeID == id
+	}
+}
@@ -0,0 +1,89 @@
+package lease
+
+import (
+	"fmt"
+)
+
+// leaseTransitions lists the statuses each LeaseStatus may move to. Leases are
+// created Assigning, and are deleted once Releasing has finished, so Releasing
+// has no way out. Assignments and transfers that fail are released
+var leaseTransitions = map[LeaseStatus][]LeaseStatus{
+	LeaseStatusUnknown: {
+		LeaseStatusAssigning,
+	},
+	LeaseStatusAssigning: {
+		LeaseStatusAssigned,
+		LeaseStatusReleasing,
+	},
+	LeaseStatusAssigned: {
+		LeaseStatusReleasing,
+		LeaseStatusOrphaned,
+		LeaseStatusTransferring,
+	},
+	LeaseStatusReleasing: {},
+	LeaseStatusOrphaned: {
+		LeaseStatusAssigned,
+		LeaseStatusReleasing,
+	},
+	LeaseStatusTransferring: {
+		LeaseStatusAssigned,
+		LeaseStatusReleasing,
+	},
+}
+
+var leaseStatusNames = map[LeaseStatus]string{
+	LeaseStatusUnknown:      "unknown",
+	LeaseStatusAssigning:    "assigning",
+	LeaseStatusAssigned:     "assigned",
+	LeaseStatusReleasing:    "releasing",
+	LeaseStatusOrphaned:     "orphaned",
+	LeaseStatusTransferring: "transferring",
+}
+
+// String returns the name of a LeaseStatus
+func (s LeaseStatus) String() string {
+	if name, ok := leaseStatusNames[s]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("LeaseStatus(%d)", int(s))
+}
+
+// CanTransition reports whether a lease may move from one status to another
+func (s LeaseStatus) CanTransition(to LeaseStatus) bool {
+	for _, allowed := range leaseTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// InvalidTransitionError is returned when a lease is moved to a status its
+// current status cannot transition to
+type InvalidTransitionError struct {
+	LeaseID string
+	From    LeaseStatus
+	To      LeaseStatus
+}
+
+func (e *InvalidTransitionError) Error() string {
+	return fmt.Sprintf("lease %q cannot transition from %s to %s", e.LeaseID, e.From, e.To)
+}
+
+// Transition moves a lease to a new status, returning an *InvalidTransitionError
+// and leaving the lease untouched if the move is not allowed
+func (l *Lease) Transition(to LeaseStatus) error {
+	if !l.Status.CanTransition(to) {
+		return &InvalidTransitionError{
+			LeaseID: l.LeaseID,
+			From:    l.Status,
+			To:      to,
+		}
+	}
+
+	l.Status = to
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package lease
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLeaseTransition(t *testing.T) {
+	tests := []struct {
+		from  LeaseStatus
+		to    LeaseStatus
+		legal bool
+	}{
+		{LeaseStatusUnknown, LeaseStatusUnknown, false},
+		{LeaseStatusUnknown, LeaseStatusAssigning, true},
+		{LeaseStatusUnknown, LeaseStatusAssigned, false},
+		{LeaseStatusUnknown, LeaseStatusReleasing, false},
+		{LeaseStatusUnknown, LeaseStatusOrphaned, false},
+		{LeaseStatusUnknown, LeaseStatusTransferring, false},
+
+		{LeaseStatusAssigning, LeaseStatusUnknown, false},
+		{LeaseStatusAssigning, LeaseStatusAssigning, false},
+		{LeaseStatusAssigning, LeaseStatusAssigned, true},
+		{LeaseStatusAssigning, LeaseStatusReleasing, true},
+		{LeaseStatusAssigning, LeaseStatusOrphaned, false},
+		{LeaseStatusAssigning, LeaseStatusTransferring, false},
+
+		{LeaseStatusAssigned, LeaseStatusUnknown, false},
+		{LeaseStatusAssigned, LeaseStatusAssigning, false},
+		{LeaseStatusAssigned, LeaseStatusAssigned, false},
+		{LeaseStatusAssigned, LeaseStatusReleasing, true},
+		{LeaseStatusAssigned, LeaseStatusOrphaned, true},
+		{LeaseStatusAssigned, LeaseStatusTransferring, true},
+
+		{LeaseStatusReleasing, LeaseStatusUnknown, false},
+		{LeaseStatusReleasing, LeaseStatusAssigning, false},
+		{LeaseStatusReleasing, LeaseStatusAssigned, false},
+		{LeaseStatusReleasing, LeaseStatusReleasing, false},
+		{LeaseStatusReleasing, LeaseStatusOrphaned, false},
+		{LeaseStatusReleasing, LeaseStatusTransferring, false},
+
+		{LeaseStatusOrphaned, LeaseStatusUnknown, false},
+		{LeaseStatusOrphaned, LeaseStatusAssigning, false},
+		{LeaseStatusOrphaned, LeaseStatusAssigned, true},
+		{LeaseStatusOrphaned, LeaseStatusReleasing, true},
+		{LeaseStatusOrphaned, LeaseStatusOrphaned, false},
+		{LeaseStatusOrphaned, LeaseStatusTransferring, false},
+
+		{LeaseStatusTransferring, LeaseStatusUnknown, false},
+		{LeaseStatusTransferring, LeaseStatusAssigning, false},
+		{LeaseStatusTransferring, LeaseStatusAssigned, true},
+		{LeaseStatusTransferring, LeaseStatusReleasing, true},
+		{LeaseStatusTransferring, LeaseStatusOrphaned, false},
+		{LeaseStatusTransferring, LeaseStatusTransferring, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from.String()+"->"+tt.to.String(), func(t *testing.T) {
+			l := &Lease{LeaseID: "foo", Status: tt.from}
+
+			err := l.Transition(tt.to)
+
+			if tt.legal {
+				if err != nil {
+					t.Fatalf("expected transition to be allowed, got %v", err)
+				}
+
+				if l.Status != tt.to {
+					t.Fatalf("expected status %s, got %s", tt.to, l.Status)
+				}
+
+				return
+			}
+
+			var invalid *InvalidTransitionError
+			if !errors.As(err, &invalid) {
+				t.Fatalf("expected an InvalidTransitionError, got %v", err)
+			}
+
+			if invalid.LeaseID != "foo" || invalid.From != tt.from || invalid.To != tt.to {
+				t.Fatalf("unexpected error contents %+v", invalid)
+			}
+
+			if l.Status != tt.from {
+				t.Fatalf("expected status to remain %s, got %s", tt.from, l.Status)
+			}
+		})
+	}
+}
+
+func TestLeaseTransitionsCoverEveryStatus(t *testing.T) {
+	for status := range leaseStatusNames {
+		if _, ok := leaseTransitions[status]; !ok {
+			t.Errorf("status %s has no transitions defined", status)
+		}
+	}
+}
@@ -9,6 +9,7 @@ type Backend interface {
 	ClientInterface
 	LeaseInterface
 	VolumeInterface
+	HistoryInterface
 }
 
 // ClientInterface defines functions for managing clients
@@ -20,18 +21,21 @@ type ClientInterface interface {
 	Clients(ClientFilterFunc) ([]ClientInfo, error)
 
 	WriteNotification(string, Notification) error
-	WatchNotifications(string, chan<- Notification) error
+	WatchNotifications(string, uint64, chan<- Notification, <-chan struct{}) error
 	AckNotification(string) error
 	WatchNotification(string) (chan struct{}, error)
+	NotificationStats() ([]NotificationStats, error)
 }
 
 // LeaseInterface defines functions for managing volume leases
 type LeaseInterface interface {
+	GetLeaseRequest(string) (*lease.LeaseRequest, error)
 	ListLeaseRequests(lease.LeaseRequestFilterFunc) ([]*lease.LeaseRequest, error)
 	AddLeaseRequest(*lease.LeaseRequest) error
 	UpdateLeaseRequest(*lease.LeaseRequest) error
 	DeleteLeaseRequest(string) error
 
+	GetLease(string) (*lease.Lease, error)
 	AddLease(*lease.Lease) error
 	ListLeases(lease.LeaseFilterFunc) ([]*lease.Lease, error)
 	UpdateLease(*lease.Lease) error
@@ -45,4 +49,12 @@ type VolumeInterface interface {
 	AddVolume(*Volume) error
 	UpdateVolume(*Volume) error
 	DeleteVolume(string) error
+	NextFencingToken(string) (uint64, error)
+}
+
+// HistoryInterface defines functions for recording the lifecycle of leases
+// and lease requests. History is append-only
+type HistoryInterface interface {
+	AddHistoryEvent(lease.HistoryEvent) error
+	ListHistory(lease.HistoryFilterFunc) ([]lease.HistoryEvent, error)
 }
@@ -21,22 +21,66 @@ type Backend struct {
 
 	leaseMap *LeaseMap
 
-	notifChMap    map[string]chan server.Notification
-	notifAckChMap map[string]chan struct{}
+	history *History
+
+	// historySize bounds how many history events are kept, the oldest being
+	// dropped past that
+	historySize int
+
+	notifications *NotificationMap
+
+	// notificationBufferSize bounds how many unacked notifications each client
+	// may have, overflowPolicy decides what happens past that
+	notificationBufferSize int
+	overflowPolicy         server.OverflowPolicy
 
 	log *log.Logger
 }
 
+// DefaultNotificationBufferSize is the number of unacked notifications each
+// client may have when not otherwise configured
+const DefaultNotificationBufferSize = 128
+
+// DefaultHistorySize is the number of history events kept when not otherwise
+// configured
+const DefaultHistorySize = 10000
+
+// Option configures optional Backend behavior
+type Option func(*Backend)
+
+// WithNotificationBuffer bounds the number of unacked notifications each
+// client may have, and sets what happens to notifications written past that
+func WithNotificationBuffer(size int, policy server.OverflowPolicy) Option {
+	return func(m *Backend) {
+		m.notificationBufferSize = size
+		m.overflowPolicy = policy
+	}
+}
+
+// WithHistorySize bounds the number of history events kept
+func WithHistorySize(size int) Option {
+	return func(m *Backend) {
+		m.historySize = size
+	}
+}
+
 // New creates an initialized empty Backend
-func New() *Backend {
+func New(opts ...Option) *Backend {
 	m := &Backend{
-		volumeMap:       NewVolumeMap(),
-		clientMap:       NewClientMap(),
-		leaseRequestMap: NewLeaseRequestMap(),
-		leaseMap:        NewLeaseMap(),
-		notifChMap:      make(map[string]chan server.Notification),
-		notifAckChMap:   make(map[string]chan struct{}),
-		log:             log.New(os.Stdout, "", log.Ldate|log.Ltime|log.Lmicroseconds|log.Lshortfile),
+		volumeMap:              NewVolumeMap(),
+		clientMap:              NewClientMap(),
+		leaseRequestMap:        NewLeaseRequestMap(),
+		leaseMap:               NewLeaseMap(),
+		history:                &History{},
+		historySize:            DefaultHistorySize,
+		notifications:          NewNotificationMap(),
+		notificationBufferSize: DefaultNotificationBufferSize,
+		overflowPolicy:         server.OverflowDropStream,
+		log:                    log.New(os.Stdout, "", log.Ldate|log.Ltime|log.Lmicroseconds|log.Lshortfile),
+	}
+
+	for _, opt := range opts {
+		opt(m)
 	}
 
 	return m
@@ -91,7 +135,12 @@ func (m *Backend) AddClient(id string) error {
 		FirstSeen: time.Now(),
 	}
 
-	m.notifChMap[id] = make(chan server.Notification)
+	m.notifications.l.Lock()
+	defer m.notifications.l.Unlock()
+
+	if _, exists := m.notifications.queues[id]; !exists {
+		m.notifications.queues[id] = newNotificationQueue(id)
+	}
 
 	return nil
 }
@@ -115,6 +164,24 @@ func (m *Backend) UpdateClient(id string, status server.ClientStatus) error {
 	return nil
 }
 
+// swapClientStatus sets the status of a given client only if it currently has
+// another given status. Unlike UpdateClient it does not count as the client
+// being seen
+func (m *Backend) swapClientStatus(id string, from, to server.ClientStatus) bool {
+	m.clientMap.l.Lock()
+	defer m.clientMap.l.Unlock()
+
+	client, exists := m.clientMap.m[id]
+	if !exists || client.Status != from {
+		return false
+	}
+
+	client.Status = to
+	m.clientMap.m[id] = client
+
+	return true
+}
+
 // RemoveClient deletes a given client from the ClientMap
 func (m *Backend) RemoveClient(id string) error {
 	m.clientMap.l.Lock()
@@ -122,8 +189,20 @@ func (m *Backend) RemoveClient(id string) error {
 
 	delete(m.clientMap.m, id)
 
-	close(m.notifChMap[id])
-	delete(m.notifChMap, id)
+	m.notifications.l.Lock()
+	defer m.notifications.l.Unlock()
+
+	if q, exists := m.notifications.queues[id]; exists {
+		for _, p := range q.pending {
+			delete(m.notifications.acks, p.n.ID)
+		}
+		for _, p := range q.dropped {
+			delete(m.notifications.acks, p.n.ID)
+		}
+
+		close(q.removed)
+		delete(m.notifications.queues, id)
+	}
 
 	return nil
 }
@@ -143,50 +222,432 @@ func (m *Backend) Clients(f server.ClientFilterFunc) ([]server.ClientInfo, error
 	return c, nil
 }
 
-// WriteNotification writes a Notification into a channel in a blocking manner
+/*
+ *
+ * Notification
+ *
+ */
+
+// notificationRedeliveryBackoff is how long to wait for an ack before first
+// redelivering a notification, doubling with each attempt up to
+// notificationRedeliveryMaxBackoff
+const notificationRedeliveryBackoff = time.Second
+const notificationRedeliveryMaxBackoff = time.Second * 30
+
+// notificationPollInterval is how often watchers check for notifications due
+// for redelivery
+const notificationPollInterval = time.Millisecond * 250
+
+// NotificationHistorySize is how many of each client's most recent
+// notifications are kept for replay when a client resumes watching. Buffers
+// may not be larger, or dropped notifications could not be replayed
+const NotificationHistorySize = 256
+
+// NotificationMap holds each client's queue of unacknowledged notifications
+type NotificationMap struct {
+	queues map[string]*NotificationQueue
+
+	// acks holds a channel per unacknowledged notification that is closed
+	// when it is acked
+	acks map[string]chan struct{}
+
+	l sync.Mutex
+}
+
+// NewNotificationMap returns an initialized NotificationMap
+func NewNotificationMap() *NotificationMap {
+	m := &NotificationMap{
+		queues: make(map[string]*NotificationQueue),
+		acks:   make(map[string]chan struct{}),
+	}
+
+	return m
+}
+
+// NotificationQueue holds a client's unacknowledged notifications in the
+// order they were written, along with its recent notification history
+type NotificationQueue struct {
+	pending []*pendingNotification
+
+	// dropped holds the unacked notifications set aside when the stream was
+	// dropped, until the client watches again or they leave the history
+	dropped []*pendingNotification
+
+	// history holds the most recent notifications, acked or not, oldest first
+	history  []server.Notification
+	sequence uint64
+
+	// wake is signaled when a notification is written
+	wake chan struct{}
+
+	// removed is closed when the client is removed
+	removed chan struct{}
+
+	// kick is closed, and replaced, to disconnect the client's watchers when
+	// its buffer overflows
+	kick chan struct{}
+
+	// unhealthy is set while the client is marked unhealthy for not keeping up
+	unhealthy bool
+
+	stats server.NotificationStats
+}
+
+type pendingNotification struct {
+	n         server.Notification
+	attempts  int
+	deliverAt time.Time
+}
+
+func newNotificationQueue(id string) *NotificationQueue {
+	return &NotificationQueue{
+		wake:    make(chan struct{}, 1),
+		removed: make(chan struct{}),
+		kick:    make(chan struct{}),
+		stats: server.NotificationStats{
+			ClientID: id,
+		},
+	}
+}
+
+// due returns the notifications ready to be delivered, in order, and schedules
+// their redelivery in case they are not acked. Redeliveries are logged, as
+// they mean the client is slow to ack or its stream went away
+func (q *NotificationQueue) due(now time.Time, l *log.Logger) []server.Notification {
+	var due []server.Notification
+
+	for _, p := range q.pending {
+		if p.deliverAt.After(now) {
+			continue
+		}
+
+		if p.attempts > 0 {
+			l.Printf("Redelivering notification %s to %q, attempt %d\n", p.n.ID, q.stats.ClientID, p.attempts+1)
+		}
+
+		q.delivered(p, now)
+
+		due = append(due, p.n)
+	}
+
+	return due
+}
+
+// replay returns the notifications after a given sequence number, in order,
+// and schedules the redelivery of those not yet acked
+func (q *NotificationQueue) replay(after uint64, now time.Time) ([]server.Notification, error) {
+	// a cursor from the future means the history was lost altogether
+	if after > q.sequence {
+		return nil, server.ErrNotificationCursorCompacted
+	}
+
+	// anything between the cursor and the oldest kept notification is gone
+	if len(q.history) > 0 && after+1 < q.history[0].Sequence {
+		return nil, server.ErrNotificationCursorCompacted
+	}
+
+	var replay []server.Notification
+	for _, n := range q.history {
+		if n.Sequence > after {
+			replay = append(replay, n)
+		}
+	}
+
+	for _, p := range q.pending {
+		if p.n.Sequence > after {
+			q.delivered(p, now)
+		}
+	}
+
+	return replay, nil
+}
+
+// delivered schedules the redelivery of a pending notification in case it is
+// not acked
+func (q *NotificationQueue) delivered(p *pendingNotification, now time.Time) {
+	backoff := notificationRedeliveryBackoff << p.attempts
+	if backoff <= 0 || backoff > notificationRedeliveryMaxBackoff {
+		backoff = notificationRedeliveryMaxBackoff
+	}
+
+	if p.attempts == 0 {
+		q.stats.Delivered++
+	} else {
+		q.stats.Redelivered++
+	}
+
+	p.attempts++
+	p.deliverAt = now.Add(backoff)
+}
+
+// remove removes the pending notification at a given index, discarding its
+// ack channel without closing it, as it was never acked
+func (m *NotificationMap) remove(q *NotificationQueue, i int) {
+	delete(m.acks, q.pending[i].n.ID)
+	q.pending = append(q.pending[:i], q.pending[i+1:]...)
+}
+
+// forget discards a dropped notification leaving the history, as it can no
+// longer be replayed
+func (m *NotificationMap) forget(q *NotificationQueue, n server.Notification) {
+	for i, p := range q.dropped {
+		if p.n.ID != n.ID {
+			continue
+		}
+
+		delete(m.acks, n.ID)
+		q.dropped = append(q.dropped[:i], q.dropped[i+1:]...)
+		q.stats.Dropped++
+		return
+	}
+}
+
+// restore moves the notifications set aside when the stream was dropped back
+// ahead of the pending ones, so they are delivered again until acked
+func (q *NotificationQueue) restore() {
+	if len(q.dropped) == 0 {
+		return
+	}
+
+	q.pending = append(q.dropped, q.pending...)
+	q.dropped = nil
+}
+
+// dropStream sets a client's pending notifications aside, emptying its buffer,
+// and disconnects its watchers. Clients resume from their cursor, replaying
+// what they missed from the history, and the notifications set aside are
+// redelivered until acked
+func (m *Backend) dropStream(id string, q *NotificationQueue) {
+	m.log.Printf("Notification buffer for %q is full, dropping %d notifications and its stream\n", id, len(q.pending))
+
+	q.dropped = append(q.dropped, q.pending...)
+	q.pending = nil
+	q.stats.StreamsDropped++
+
+	close(q.kick)
+	q.kick = make(chan struct{})
+}
+
+// coalesce makes room for a new notification by discarding the pending
+// notifications it supersedes. If none are superseded, the stream is dropped
+// instead, as discarding any other notification would lose it for good
+func (m *Backend) coalesce(id string, q *NotificationQueue, n server.Notification) {
+	if key := n.CoalesceKey(); key != "" {
+		for i := 0; i < len(q.pending); i++ {
+			if q.pending[i].n.CoalesceKey() != key {
+				continue
+			}
+
+			m.log.Printf("Coalescing notification %s for %q into %s\n", q.pending[i].n.ID, id, n.ID)
+
+			m.notifications.remove(q, i)
+			q.stats.Coalesced++
+			i--
+		}
+	}
+
+	if len(q.pending) >= m.notificationBufferSize {
+		m.dropStream(id, q)
+	}
+}
+
+// WriteNotification queues a Notification for a client until it is acked. If
+// the client's buffer is full the overflow policy applies, and with
+// server.OverflowMarkUnhealthy the notification is dropped and
+// server.ErrNotificationBufferFull returned
 func (m *Backend) WriteNotification(id string, n server.Notification) error {
-	ch, exists := m.notifChMap[id]
+	m.notifications.l.Lock()
+
+	q, exists := m.notifications.queues[id]
 	if !exists {
-		return fmt.Errorf("no notification channel found for %q", id)
+		m.notifications.l.Unlock()
+		return fmt.Errorf("no notification queue found for %q", id)
+	}
+
+	if len(q.pending) >= m.notificationBufferSize {
+		switch m.overflowPolicy {
+		case server.OverflowDropStream:
+			m.dropStream(id, q)
+		case server.OverflowCoalesce:
+			m.coalesce(id, q, n)
+		case server.OverflowMarkUnhealthy:
+			q.stats.Dropped++
+
+			mark := !q.unhealthy
+			q.unhealthy = true
+			m.notifications.l.Unlock()
+
+			m.log.Printf("Notification buffer for %q is full, dropping notification %s\n", id, n.ID)
+
+			// the client lock is taken before the notification lock elsewhere
+			if mark && (m.swapClientStatus(id, server.AliveClientStatus, server.UnhealthyClientStatus) ||
+				m.swapClientStatus(id, server.UnknownClientStatus, server.UnhealthyClientStatus)) {
+				m.log.Printf("Marking %q as unhealthy\n", id)
+			}
+
+			return server.ErrNotificationBufferFull
+		}
+	}
+	defer m.notifications.l.Unlock()
+
+	q.stats.Written++
+
+	q.sequence++
+	n.Sequence = q.sequence
+
+	q.history = append(q.history, n)
+	if len(q.history) > NotificationHistorySize {
+		m.notifications.forget(q, q.history[0])
+		q.history = q.history[1:]
 	}
 
-	ch <- n
-	m.notifAckChMap[n.ID] = make(chan struct{})
+	q.pending = append(q.pending, &pendingNotification{n: n})
+	m.notifications.acks[n.ID] = make(chan struct{})
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
 
 	return nil
 }
 
-// WatchNotifications writes notifications to a given channel
-func (m *Backend) WatchNotifications(id string, ch chan<- server.Notification) error {
-	notifCh, exists := m.notifChMap[id]
+// WatchNotifications writes a client's notifications to a given channel until
+// done is closed. Every unacked notification is delivered again straight away,
+// in order, and then redelivered with backoff until it is acked. A non-zero
+// resumeAfter first replays every notification after that sequence number
+func (m *Backend) WatchNotifications(id string, resumeAfter uint64, ch chan<- server.Notification, done <-chan struct{}) error {
+	m.notifications.l.Lock()
+	q, exists := m.notifications.queues[id]
 	if !exists {
+		m.notifications.l.Unlock()
 		return fmt.Errorf("unknown id %q", id)
 	}
 
-	for n := range notifCh {
-		ch <- n
+	q.restore()
+	for _, p := range q.pending {
+		p.deliverAt = time.Time{}
 	}
 
-	return nil
+	kick := q.kick
+
+	var due []server.Notification
+	if resumeAfter > 0 {
+		var err error
+		due, err = q.replay(resumeAfter, time.Now())
+		if err != nil {
+			m.notifications.l.Unlock()
+			return err
+		}
+	}
+	m.notifications.l.Unlock()
+
+	t := time.NewTicker(notificationPollInterval)
+	defer t.Stop()
+
+	for {
+		m.notifications.l.Lock()
+		due = append(due, q.due(time.Now(), m.log)...)
+		m.notifications.l.Unlock()
+
+		for _, n := range due {
+			select {
+			case ch <- n:
+			case <-done:
+				return nil
+			case <-q.removed:
+				return nil
+			case <-kick:
+				return server.ErrNotificationStreamDropped
+			}
+		}
+		due = nil
+
+		select {
+		case <-done:
+			return nil
+		case <-q.removed:
+			return nil
+		case <-kick:
+			return server.ErrNotificationStreamDropped
+		case <-q.wake:
+		case <-t.C:
+		}
+	}
 }
 
-// AckNotification acks a notification
+// AckNotification acks a notification, removing it from its client's queue.
+// Redelivered notifications may be acked more than once, so acking an unknown
+// notification is not an error
 func (m *Backend) AckNotification(id string) error {
-	ch, exists := m.notifAckChMap[id]
+	m.notifications.l.Lock()
+
+	ch, exists := m.notifications.acks[id]
 	if !exists {
-		return fmt.Errorf("failed to acknowledge %q, channel does not exist", id)
+		m.notifications.l.Unlock()
+		return nil
 	}
 
 	close(ch)
-	delete(m.notifAckChMap, id)
+	delete(m.notifications.acks, id)
+
+	// an unhealthy client is healthy again once it has caught up on half its
+	// buffer
+	var recovered string
+	for clientID, q := range m.notifications.queues {
+		for i, p := range q.dropped {
+			if p.n.ID == id {
+				q.dropped = append(q.dropped[:i], q.dropped[i+1:]...)
+				break
+			}
+		}
+
+		for i, p := range q.pending {
+			if p.n.ID != id {
+				continue
+			}
+
+			q.pending = append(q.pending[:i], q.pending[i+1:]...)
+
+			if q.unhealthy && len(q.pending) <= m.notificationBufferSize/2 {
+				q.unhealthy = false
+				recovered = clientID
+			}
+			break
+		}
+	}
+	m.notifications.l.Unlock()
+
+	if recovered != "" && m.swapClientStatus(recovered, server.UnhealthyClientStatus, server.AliveClientStatus) {
+		m.log.Printf("Client %q caught up on its notifications, marking as alive\n", recovered)
+	}
 
 	return nil
 }
 
+// NotificationStats returns the notification counters of every client
+func (m *Backend) NotificationStats() ([]server.NotificationStats, error) {
+	m.notifications.l.Lock()
+	defer m.notifications.l.Unlock()
+
+	var stats []server.NotificationStats
+	for _, q := range m.notifications.queues {
+		s := q.stats
+		s.Pending = len(q.pending) + len(q.dropped)
+
+		stats = append(stats, s)
+	}
+
+	return stats, nil
+}
+
 // WatchNotification returns a channel that will be closed when the
 // notification is acked
 func (m *Backend) WatchNotification(id string) (chan struct{}, error) {
-	ch, exists := m.notifAckChMap[id]
+	m.notifications.l.Lock()
+	defer m.notifications.l.Unlock()
+
+	ch, exists := m.notifications.acks[id]
 	if !exists {
 		return nil, fmt.Errorf("channel does not exist")
 	}
@@ -215,6 +676,19 @@ func NewLeaseRequestMap() *LeaseRequestMap {
 	return m
 }
 
+// GetLeaseRequest returns a LeaseRequest for a given lease request id
+func (m *Backend) GetLeaseRequest(id string) (*lease.LeaseRequest, error) {
+	m.leaseRequestMap.l.Lock()
+	defer m.leaseRequestMap.l.Unlock()
+
+	request, ok := m.leaseRequestMap.m[id]
+	if !ok {
+		m.log.Printf("No lease request %q found in memory map\n", id)
+	}
+
+	return request, nil
+}
+
 // AddLeaseRequest adds a LeaseRequest to the backend
 func (m *Backend) AddLeaseRequest(request *lease.LeaseRequest) error {
 	m.leaseRequestMap.l.Lock()
@@ -293,6 +767,19 @@ func NewLeaseMap() *LeaseMap {
 	return m
 }
 
+// GetLease returns a Lease for a given lease id
+func (m *Backend) GetLease(id string) (*lease.Lease, error) {
+	m.leaseMap.l.Lock()
+	defer m.leaseMap.l.Unlock()
+
+	l, ok := m.leaseMap.m[id]
+	if !ok {
+		m.log.Printf("No lease %q found in memory map\n", id)
+	}
+
+	return l, nil
+}
+
 // AddLease adds a Lease to the backend
 func (m *Backend) AddLease(lease *lease.Lease) error {
 	m.leaseMap.l.Lock()
@@ -419,10 +906,16 @@ func (m *Backend) UpdateVolume(volume *server.Volume) error {
 	m.volumeMap.l.Lock()
 	defer m.volumeMap.l.Unlock()
 
-	if _, exists := m.volumeMap.m[volume.ID]; !exists {
+	existing, exists := m.volumeMap.m[volume.ID]
+	if !exists {
 		return fmt.Errorf("Volume %q does not exist in memory backend", volume.ID)
 	}
 
+	// tokens issued since the caller read the volume must not be undone
+	if existing.FencingToken > volume.FencingToken {
+		volume.FencingToken = existing.FencingToken
+	}
+
 	m.volumeMap.m[volume.ID] = volume
 
 	return nil
@@ -441,3 +934,60 @@ func (m *Backend) DeleteVolume(id string) error {
 
 	return nil
 }
+
+// NextFencingToken satisfies server.Backend
+func (m *Backend) NextFencingToken(id string) (uint64, error) {
+	m.volumeMap.l.Lock()
+	defer m.volumeMap.l.Unlock()
+
+	volume, exists := m.volumeMap.m[id]
+	if !exists {
+		return 0, fmt.Errorf("Volume %q does not exist in memory backend", id)
+	}
+
+	volume.FencingToken++
+
+	return volume.FencingToken, nil
+}
+
+/*
+ *
+ * History
+ *
+ */
+
+// History holds the most recent lease and lease request history events in the
+// order they were recorded
+type History struct {
+	events []lease.HistoryEvent
+	l      sync.Mutex
+}
+
+// AddHistoryEvent appends a HistoryEvent to the backend, dropping the oldest
+// event if the history is full
+func (m *Backend) AddHistoryEvent(e lease.HistoryEvent) error {
+	m.history.l.Lock()
+	defer m.history.l.Unlock()
+
+	m.history.events = append(m.history.events, e)
+	if len(m.history.events) > m.historySize {
+		m.history.events = m.history.events[1:]
+	}
+
+	return nil
+}
+
+// ListHistory returns a list of lease.HistoryEvent, oldest first
+func (m *Backend) ListHistory(f lease.HistoryFilterFunc) ([]lease.HistoryEvent, error) {
+	m.history.l.Lock()
+	defer m.history.l.Unlock()
+
+	var events []lease.HistoryEvent
+	for _, e := range m.history.events {
+		if f(e) {
+			events = append(events, e)
+		}
+	}
+
+	return events, nil
+}
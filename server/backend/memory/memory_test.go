@@ -0,0 +1,161 @@
+package memory
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/p0pr0ck5/volchestrator/server"
+)
+
+func TestUpdateVolumeKeepsFencingToken(t *testing.T) {
+	m := New()
+
+	err := m.AddVolume(&server.Volume{ID: "v"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// an update built from a read taken before tokens were issued
+	stale := server.Volume{ID: "v", Status: server.AvailableVolumeStatus}
+
+	for i := 0; i < 2; i++ {
+		_, err := m.NextFencingToken("v")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err = m.UpdateVolume(&stale)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := m.NextFencingToken("v")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if token != 3 {
+		t.Errorf("got token %d after a stale update, want 3", token)
+	}
+}
+
+func TestUpdateVolumeConcurrentFencingTokens(t *testing.T) {
+	m := New()
+
+	err := m.AddVolume(&server.Volume{ID: "v"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const n = 100
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	tokens := make(chan uint64, n)
+
+	go func() {
+		defer wg.Done()
+
+		for i := 0; i < n; i++ {
+			token, err := m.NextFencingToken("v")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			tokens <- token
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		for i := 0; i < n; i++ {
+			v, err := m.GetVolume("v")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			updated := *v
+			err = m.UpdateVolume(&updated)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+
+	wg.Wait()
+	close(tokens)
+
+	var last uint64
+	for token := range tokens {
+		if token <= last {
+			t.Fatalf("token %d issued after %d", token, last)
+		}
+		last = token
+	}
+}
+
+func TestDroppedNotificationsRedeliveredOnResume(t *testing.T) {
+	m := New(WithNotificationBuffer(2, server.OverflowDropStream))
+
+	err := m.AddClient("c")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	for i := 0; i < 3; i++ {
+		n := server.NewNotification(server.LeaseRevokedNotificationType, server.LeaseRevokedPayload{})
+		ids = append(ids, n.ID)
+
+		err := m.WriteNotification("c", n)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the first two were dropped with the stream, but are still unacked
+	_, err = m.WatchNotification(ids[0])
+	if err != nil {
+		t.Fatalf("dropped notification lost its ack channel: %v", err)
+	}
+
+	ch := make(chan server.Notification)
+	done := make(chan struct{})
+	defer close(done)
+
+	go m.WatchNotifications("c", 1, ch, done)
+
+	seen := make(map[string]bool)
+	timeout := time.After(time.Second * 5)
+	for len(seen) < len(ids) {
+		select {
+		case n := <-ch:
+			seen[n.ID] = true
+		case <-timeout:
+			t.Fatalf("got %d of %d notifications after resuming", len(seen), len(ids))
+		}
+	}
+
+	for _, id := range ids {
+		err := m.AckNotification(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stats, err := m.NotificationStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stats[0].Pending != 0 || stats[0].Dropped != 0 {
+		t.Errorf("got %d pending and %d dropped after acking, want none", stats[0].Pending, stats[0].Dropped)
+	}
+}
@@ -17,6 +17,10 @@ const (
 
 	// LeftClientStatus indicates the client intentionally left
 	LeftClientStatus
+
+	// UnhealthyClientStatus indicates the client is alive but not keeping up
+	// with its notifications, so new notifications are dropped until it does
+	UnhealthyClientStatus
 )
 
 // ClientInfo details information about a given client
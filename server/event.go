@@ -0,0 +1,320 @@
+package server
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/p0pr0ck5/volchestrator/lease"
+	svc "github.com/p0pr0ck5/volchestrator/svc"
+)
+
+// EventType defines the type of an operational Event
+type EventType int
+
+const (
+	// UnknownEventType is a base value
+	UnknownEventType EventType = iota
+
+	// ClientRegisteredEventType is emitted when a client registers, or a dead client comes back
+	ClientRegisteredEventType
+
+	// ClientDeregisteredEventType is emitted when a client deregisters
+	ClientDeregisteredEventType
+
+	// ClientDeadEventType is emitted when a client stops heartbeating
+	ClientDeadEventType
+
+	// VolumeAddedEventType is emitted when a volume is added
+	VolumeAddedEventType
+
+	// VolumeUpdatedEventType is emitted when a volume is updated by an operator
+	VolumeUpdatedEventType
+
+	// VolumeDeletedEventType is emitted when a volume is deleted
+	VolumeDeletedEventType
+
+	// LeaseRequestSubmittedEventType is emitted when a lease request is submitted
+	LeaseRequestSubmittedEventType
+
+	// LeaseRequestExpiredEventType is emitted when a lease request expires without being renewed
+	LeaseRequestExpiredEventType
+
+	// LeaseOfferedEventType is emitted when a volume is offered to a lease request
+	LeaseOfferedEventType
+
+	// LeaseAckedEventType is emitted when a client acks a volume offer
+	LeaseAckedEventType
+
+	// LeaseAssignedEventType is emitted once a lease's volume is associated with its client
+	LeaseAssignedEventType
+
+	// LeaseReleasedEventType is emitted once a lease's volume is returned to the pool
+	LeaseReleasedEventType
+
+	// ResourceErrorEventType is emitted when the resource manager fails to associate
+	// or disassociate a volume
+	ResourceErrorEventType
+
+	// LeaseRequestUpdatedEventType is emitted when a client changes a pending lease request
+	LeaseRequestUpdatedEventType
+
+	// LeaseRequestCancelledEventType is emitted when a client withdraws a pending lease request
+	LeaseRequestCancelledEventType
+)
+
+var eventTypeNames = map[EventType]string{
+	UnknownEventType:               "unknown",
+	ClientRegisteredEventType:      "client_registered",
+	ClientDeregisteredEventType:    "client_deregistered",
+	ClientDeadEventType:            "client_dead",
+	VolumeAddedEventType:           "volume_added",
+	VolumeUpdatedEventType:         "volume_updated",
+	VolumeDeletedEventType:         "volume_deleted",
+	LeaseRequestSubmittedEventType: "lease_request_submitted",
+	LeaseRequestExpiredEventType:   "lease_request_expired",
+	LeaseOfferedEventType:          "lease_offered",
+	LeaseAckedEventType:            "lease_acked",
+	LeaseAssignedEventType:         "lease_assigned",
+	LeaseReleasedEventType:         "lease_released",
+	ResourceErrorEventType:         "resource_error",
+	LeaseRequestUpdatedEventType:   "lease_request_updated",
+	LeaseRequestCancelledEventType: "lease_request_cancelled",
+}
+
+func (t EventType) String() string {
+	if name, ok := eventTypeNames[t]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("EventType(%d)", int(t))
+}
+
+// ParseEventType returns the EventType with a given name, e.g. lease_assigned
+func ParseEventType(s string) (EventType, error) {
+	for t, name := range eventTypeNames {
+		if name == s && t != UnknownEventType {
+			return t, nil
+		}
+	}
+
+	return UnknownEventType, fmt.Errorf("invalid event type %q", s)
+}
+
+// eventBufferSize is how many events each watcher may fall behind by before
+// further events are dropped for it
+const eventBufferSize = 256
+
+// Event describes something the server did, for operators to observe
+type Event struct {
+	Time time.Time
+	Type EventType
+
+	ClientID       string
+	VolumeID       string
+	LeaseRequestID string
+	LeaseID        string
+
+	Message string
+}
+
+// EventFilter narrows the events sent to a watcher. Empty fields match
+// every event
+type EventFilter struct {
+	Types    []EventType
+	ClientID string
+	VolumeID string
+}
+
+func (f EventFilter) match(e Event) bool {
+	if f.ClientID != "" && e.ClientID != f.ClientID {
+		return false
+	}
+
+	if f.VolumeID != "" && e.VolumeID != f.VolumeID {
+		return false
+	}
+
+	if len(f.Types) == 0 {
+		return true
+	}
+
+	for _, t := range f.Types {
+		if t == e.Type {
+			return true
+		}
+	}
+
+	return false
+}
+
+// eventBus fans events out to watchers. Publishing never blocks, so a slow
+// watcher misses events rather than holding up the server
+type eventBus struct {
+	watchers map[*eventWatcher]bool
+	l        sync.Mutex
+}
+
+type eventWatcher struct {
+	ch      chan Event
+	filter  EventFilter
+	dropped uint64
+}
+
+func newEventBus() *eventBus {
+	return &eventBus{
+		watchers: make(map[*eventWatcher]bool),
+	}
+}
+
+func (b *eventBus) subscribe(f EventFilter) *eventWatcher {
+	b.l.Lock()
+	defer b.l.Unlock()
+
+	w := &eventWatcher{
+		ch:     make(chan Event, eventBufferSize),
+		filter: f,
+	}
+	b.watchers[w] = true
+
+	return w
+}
+
+func (b *eventBus) unsubscribe(w *eventWatcher) {
+	b.l.Lock()
+	defer b.l.Unlock()
+
+	delete(b.watchers, w)
+}
+
+// publish sends an event to every matching watcher, returning how many
+// watchers it was dropped for
+func (b *eventBus) publish(e Event) int {
+	b.l.Lock()
+	defer b.l.Unlock()
+
+	dropped := 0
+	for w := range b.watchers {
+		if !w.filter.match(e) {
+			continue
+		}
+
+		select {
+		case w.ch <- e:
+		default:
+			w.dropped++
+			dropped++
+		}
+	}
+
+	return dropped
+}
+
+// emit publishes an event to the admin event watchers and event handlers
+func (s *Server) emit(e Event) {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+
+	if dropped := s.events.publish(e); dropped > 0 {
+		s.log.Printf("Dropped event for %d slow event watchers", dropped)
+	}
+
+	for _, h := range s.eventHandlers {
+		h(e)
+	}
+}
+
+// leaseRequestEventTypes and leaseEventTypes map the history states worth
+// announcing to operators to their events
+var leaseRequestEventTypes = map[lease.HistoryState]EventType{
+	lease.HistoryStateRequested: LeaseRequestSubmittedEventType,
+	lease.HistoryStateExpired:   LeaseRequestExpiredEventType,
+	lease.HistoryStateOffered:   LeaseOfferedEventType,
+	lease.HistoryStateAcked:     LeaseAckedEventType,
+	lease.HistoryStateUpdated:   LeaseRequestUpdatedEventType,
+	lease.HistoryStateCancelled: LeaseRequestCancelledEventType,
+}
+
+var leaseEventTypes = map[lease.HistoryState]EventType{
+	lease.HistoryStateAssigned: LeaseAssignedEventType,
+	lease.HistoryStateReleased: LeaseReleasedEventType,
+}
+
+// emitHistory publishes the event, if any, for a lease or lease request
+// history event
+func (s *Server) emitHistory(h lease.HistoryEvent, types map[lease.HistoryState]EventType) {
+	t, ok := types[h.State]
+	if !ok {
+		return
+	}
+
+	s.emit(Event{
+		Time:           h.Time,
+		Type:           t,
+		ClientID:       h.ClientID,
+		VolumeID:       h.VolumeID,
+		LeaseRequestID: h.LeaseRequestID,
+		LeaseID:        h.LeaseID,
+		Message:        h.Reason,
+	})
+}
+
+// emitResourceError publishes a resource manager failure for a lease
+func (s *Server) emitResourceError(l *lease.Lease, err error) {
+	s.emit(Event{
+		Type:           ResourceErrorEventType,
+		ClientID:       l.ClientID,
+		VolumeID:       l.VolumeID,
+		LeaseRequestID: l.LeaseRequestID,
+		LeaseID:        l.LeaseID,
+		Message:        err.Error(),
+	})
+}
+
+// WatchEvents streams the server's events matching a filter until the
+// watcher goes away
+func (s *Server) WatchEvents(f *svc.EventFilter, stream svc.VolchestratorAdmin_WatchEventsServer) error {
+	filter := EventFilter{
+		ClientID: f.ClientId,
+		VolumeID: f.VolumeId,
+	}
+
+	for _, t := range f.Types {
+		if _, ok := svc.EventType_name[int32(t)]; !ok || t == svc.EventType_EVENTUNKNOWN {
+			return status.Errorf(codes.InvalidArgument, "invalid event type %d", t)
+		}
+
+		filter.Types = append(filter.Types, EventType(t))
+	}
+
+	w := s.events.subscribe(filter)
+	defer s.events.unsubscribe(w)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case e := <-w.ch:
+			t, _ := ptypes.TimestampProto(e.Time)
+
+			err := stream.Send(&svc.Event{
+				Time:           t,
+				Type:           svc.EventType(e.Type),
+				ClientId:       e.ClientID,
+				VolumeId:       e.VolumeID,
+				LeaseRequestId: e.LeaseRequestID,
+				LeaseId:        e.LeaseID,
+				Message:        e.Message,
+			})
+			if err != nil {
+				s.log.Println(err)
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"time"
+
+	"github.com/p0pr0ck5/volchestrator/lease"
+)
+
+// expiring reports whether something with a given TTL and expiry has less
+// than the warning fraction of its TTL left
+func (s *Server) expiring(expires time.Time, ttl time.Duration, now time.Time) bool {
+	remaining := expires.Sub(now)
+	if remaining <= 0 {
+		return false
+	}
+
+	return remaining <= time.Duration(float64(ttl)*s.expiryWarningFraction)
+}
+
+// warnExpiring notifies clients of leases and lease requests that are close
+// to expiring without having been renewed. Each expiry is only warned about
+// once, so renewing a lease or lease request re-arms its warning
+func (s *Server) warnExpiring() {
+	now := time.Now()
+
+	leases, err := s.b.ListLeases(lease.LeaseFilterAll)
+	if err != nil {
+		s.log.Println(err)
+		return
+	}
+
+	for _, l := range leases {
+		// orphaned leases have no client to warn
+		if l.Status != lease.LeaseStatusAssigned {
+			continue
+		}
+
+		if l.WarnedExpires.Equal(l.Expires) || !s.expiring(l.Expires, l.TTL, now) {
+			continue
+		}
+
+		l.WarnedExpires = l.Expires
+		err := s.b.UpdateLease(l)
+		if err != nil {
+			s.log.Println(err)
+			continue
+		}
+
+		s.log.Println("Lease", l.LeaseID, "is about to expire")
+
+		s.writeNotification(l.ClientID, NewNotification(
+			LeaseExpiringNotificationType,
+			LeaseExpiringPayload{
+				LeaseID: l.LeaseID,
+				Expires: l.Expires,
+			},
+		))
+	}
+
+	requests, err := s.b.ListLeaseRequests(lease.LeaseRequestFilterAll)
+	if err != nil {
+		s.log.Println(err)
+		return
+	}
+
+	warnedGangs := make(map[string]bool)
+
+	for _, request := range requests {
+		if request.WarnedExpires.Equal(request.Expires) || !s.expiring(request.Expires, request.TTL, now) {
+			continue
+		}
+
+		request.WarnedExpires = request.Expires
+		err := s.b.UpdateLeaseRequest(request)
+		if err != nil {
+			s.log.Println(err)
+			continue
+		}
+
+		// gang lease requests are renewed together by their gang ID
+		payload := LeaseExpiringPayload{
+			LeaseRequestID: request.LeaseRequestID,
+			Expires:        request.Expires,
+		}
+		if request.GangID != "" {
+			if warnedGangs[request.GangID] {
+				continue
+			}
+			warnedGangs[request.GangID] = true
+
+			payload.LeaseRequestID = ""
+			payload.GangID = request.GangID
+		}
+
+		s.log.Println("Lease request", request.LeaseRequestID, "is about to expire")
+
+		s.writeNotification(request.ClientID, NewNotification(
+			LeaseExpiringNotificationType,
+			payload,
+		))
+	}
+}
@@ -0,0 +1,143 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/p0pr0ck5/volchestrator/lease"
+	svc "github.com/p0pr0ck5/volchestrator/svc"
+)
+
+// ExplainLeaseRequest evaluates a pending LeaseRequest against every volume,
+// returning the reasons each volume has not been leased to it. Given a gang
+// ID, each of the gang's lease requests is explained
+func (s *Server) ExplainLeaseRequest(ctx context.Context, id *svc.LeaseRequestID) (*svc.LeaseRequestExplanation, error) {
+	request, err := s.b.GetLeaseRequest(id.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	volumes, err := s.b.ListVolumes(VolumeFilterAll)
+	if err != nil {
+		return nil, err
+	}
+
+	if request != nil {
+		return s.explainLeaseRequest(volumes, request)
+	}
+
+	members, err := s.b.ListLeaseRequests(lease.LeaseRequestFilterByGang(id.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(members) == 0 {
+		return nil, status.Errorf(codes.NotFound, "lease request %q not found", id.Id)
+	}
+
+	res := &svc.LeaseRequestExplanation{
+		LeaseRequestId: id.Id,
+		GangId:         id.Id,
+		Volumes:        []*svc.VolumeExplanation{},
+		Members:        []*svc.LeaseRequestExplanation{},
+	}
+
+	for _, member := range members {
+		explanation, err := s.explainLeaseRequest(volumes, member)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Members = append(res.Members, explanation)
+	}
+
+	return res, nil
+}
+
+// explainLeaseRequest evaluates a single LeaseRequest against the given volumes
+func (s *Server) explainLeaseRequest(volumes []*Volume, request *lease.LeaseRequest) (*svc.LeaseRequestExplanation, error) {
+	res := &svc.LeaseRequestExplanation{
+		LeaseRequestId: request.LeaseRequestID,
+		GangId:         request.GangID,
+		Volumes:        []*svc.VolumeExplanation{},
+	}
+
+	for _, volume := range volumes {
+		reasons, err := s.explainVolume(volume, request)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Volumes = append(res.Volumes, &svc.VolumeExplanation{
+			VolumeId: volume.ID,
+			Eligible: len(reasons) == 0,
+			Reasons:  reasons,
+		})
+	}
+
+	return res, nil
+}
+
+// explainVolume returns the reasons a volume cannot currently be leased to a
+// request, using the same checks the scheduler applies in
+// watchLeaseRequestIterations and tryLease
+func (s *Server) explainVolume(volume *Volume, request *lease.LeaseRequest) ([]string, error) {
+	reasons := leaseRequestMismatches(volume, request)
+
+	switch volume.Status {
+	case AvailableVolumeStatus, SharedLeasedVolumeStatus:
+		if request.GangID != "" && !gangVolumeEligible(volume) {
+			reasons = append(reasons, "gang lease requests are only offered volumes without leases")
+		}
+	case LeasedVolumeStatus:
+		holder, err := s.volumeHolder(volume)
+		if err != nil {
+			return nil, err
+		}
+
+		reasons = append(reasons, fmt.Sprintf("volume is leased by %s", holder))
+	case LeasePendingVolumeStatus:
+		if offer := s.offers.get(volume.ID); offer != nil {
+			reasons = append(reasons, fmt.Sprintf("volume has a pending offer to client %q for lease request %q",
+				offer.ClientID, offer.LeaseRequestID))
+			break
+		}
+
+		// the offer was acked and the lease is being assigned
+		holder, err := s.volumeHolder(volume)
+		if err != nil {
+			return nil, err
+		}
+
+		reasons = append(reasons, fmt.Sprintf("volume is being leased by %s", holder))
+	default:
+		reasons = append(reasons, "volume status is unknown")
+	}
+
+	if err := s.checkLeaseQuota(request.ClientID, volume); err != nil {
+		reasons = append(reasons, err.Error())
+	}
+
+	if err := s.checkReaders(volume, request); err != nil {
+		reasons = append(reasons, err.Error())
+	}
+
+	return reasons, nil
+}
+
+// volumeHolder describes the client holding a lease on a volume
+func (s *Server) volumeHolder(volume *Volume) (string, error) {
+	leases, err := s.b.ListLeases(lease.LeaseFilterByVolume(volume.ID))
+	if err != nil {
+		return "", err
+	}
+
+	if len(leases) == 0 {
+		return "an unknown client", nil
+	}
+
+	return fmt.Sprintf("client %q", leases[0].ClientID), nil
+}
@@ -0,0 +1,117 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/p0pr0ck5/volchestrator/lease"
+	svc "github.com/p0pr0ck5/volchestrator/svc"
+)
+
+// recordLeaseRequest adds a lease request state transition to the history.
+// volumeID is empty until the request has been offered a volume
+func (s *Server) recordLeaseRequest(request *lease.LeaseRequest, volumeID string, state lease.HistoryState, reason string) {
+	e := lease.HistoryEvent{
+		Time:           time.Now(),
+		LeaseRequestID: request.LeaseRequestID,
+		ClientID:       request.ClientID,
+		VolumeID:       volumeID,
+		State:          state,
+		Reason:         reason,
+	}
+
+	err := s.b.AddHistoryEvent(e)
+	if err != nil {
+		s.log.Println(err)
+	}
+
+	s.emitHistory(e, leaseRequestEventTypes)
+}
+
+// recordLease adds a lease state transition to the history
+func (s *Server) recordLease(l *lease.Lease, state lease.HistoryState, reason string) {
+	e := lease.HistoryEvent{
+		Time:           time.Now(),
+		LeaseRequestID: l.LeaseRequestID,
+		LeaseID:        l.LeaseID,
+		ClientID:       l.ClientID,
+		VolumeID:       l.VolumeID,
+		State:          state,
+		Reason:         reason,
+	}
+
+	err := s.b.AddHistoryEvent(e)
+	if err != nil {
+		s.log.Println(err)
+	}
+
+	s.emitHistory(e, leaseEventTypes)
+}
+
+// ListLeaseHistory returns the recorded history of leases and lease requests,
+// oldest first, narrowed by any of the given volume, client, lease and time range
+func (s *Server) ListLeaseHistory(ctx context.Context, q *svc.HistoryQuery) (*svc.HistoryEventList, error) {
+	filters := []lease.HistoryFilterFunc{}
+
+	if q.VolumeId != "" {
+		filters = append(filters, lease.HistoryFilterByVolume(q.VolumeId))
+	}
+
+	if q.ClientId != "" {
+		filters = append(filters, lease.HistoryFilterByClient(q.ClientId))
+	}
+
+	if q.LeaseId != "" {
+		filters = append(filters, lease.HistoryFilterByLease(q.LeaseId))
+	}
+
+	if q.Since != nil || q.Until != nil {
+		var since, until time.Time
+		var err error
+
+		if q.Since != nil {
+			since, err = ptypes.Timestamp(q.Since)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+
+		if q.Until != nil {
+			until, err = ptypes.Timestamp(q.Until)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+
+		filters = append(filters, lease.HistoryFilterByTime(since, until))
+	}
+
+	events, err := s.b.ListHistory(lease.HistoryFilterAnd(filters...))
+	if err != nil {
+		return nil, err
+	}
+
+	res := &svc.HistoryEventList{}
+	l := []*svc.HistoryEvent{}
+
+	for _, e := range events {
+		t, _ := ptypes.TimestampProto(e.Time)
+		l = append(l, &svc.HistoryEvent{
+			Time:           t,
+			LeaseRequestId: e.LeaseRequestID,
+			LeaseId:        e.LeaseID,
+			ClientId:       e.ClientID,
+			VolumeId:       e.VolumeID,
+			State:          svc.HistoryState(e.State),
+			Reason:         e.Reason,
+		})
+	}
+
+	res.Events = l
+
+	return res, nil
+}
@@ -0,0 +1,187 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thanhpk/randstr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	svc "github.com/p0pr0ck5/volchestrator/svc"
+)
+
+// idempotencyKeyTTL is how long an idempotency key keeps mapping to the lease
+// request or gang first submitted with it, so retries within that time are
+// deduped
+const idempotencyKeyTTL = time.Minute * 10
+
+// idempotencyKeys maps the idempotency keys clients submit lease requests and
+// gang lease requests with to the lease requests and gangs they created
+type idempotencyKeys struct {
+	m map[string]*idempotentSubmission
+	l sync.Mutex
+}
+
+// idempotentSubmission is a lease request or gang submitted with an
+// idempotency key. done is closed once the submission has succeeded or failed,
+// as retries can arrive while the first attempt is still in flight
+type idempotentSubmission struct {
+	id      string
+	request string
+	expires time.Time
+
+	done chan struct{}
+	err  error
+}
+
+// idempotentRequest holds the fields of a lease request that a retry must
+// repeat for the idempotency key to be reused
+type idempotentRequest struct {
+	tag              string
+	availabilityZone string
+	volumeID         string
+	priority         int32
+	ttl              time.Duration
+	accessMode       svc.LeaseAccessMode
+}
+
+func newIdempotentRequest(request *svc.LeaseRequest) idempotentRequest {
+	return idempotentRequest{
+		tag:              request.Tag,
+		availabilityZone: request.AvailabilityZone,
+		volumeID:         request.VolumeId,
+		priority:         request.Priority,
+		ttl:              request.Ttl.AsDuration(),
+		accessMode:       request.AccessMode,
+	}
+}
+
+// idempotentGangRequest holds the fields of a gang lease request that a retry
+// must repeat
+type idempotentGangRequest struct {
+	ttl      time.Duration
+	requests []idempotentRequest
+}
+
+func newIdempotentGangRequest(gang *svc.GangLeaseRequest) idempotentGangRequest {
+	r := idempotentGangRequest{
+		ttl: gang.Ttl.AsDuration(),
+	}
+
+	for _, request := range gang.Requests {
+		r.requests = append(r.requests, newIdempotentRequest(request))
+	}
+
+	return r
+}
+
+func newIdempotencyKeys() *idempotencyKeys {
+	return &idempotencyKeys{
+		m: make(map[string]*idempotentSubmission),
+	}
+}
+
+// reserve returns the submission for a client's idempotency key, and whether
+// it was created by this call, in which case the caller must complete it
+func (k *idempotencyKeys) reserve(clientID, key string, request interface{}) (*idempotentSubmission, bool, error) {
+	k.l.Lock()
+	defer k.l.Unlock()
+
+	r := fmt.Sprintf("%+v", request)
+
+	sub, exists := k.m[clientID+"/"+key]
+	if exists && sub.expires.After(time.Now()) {
+		if sub.request != r {
+			return nil, false, status.Errorf(codes.InvalidArgument, "idempotency key %q was already used for a different lease request", key)
+		}
+
+		return sub, false, nil
+	}
+
+	sub = &idempotentSubmission{
+		id:      randstr.Hex(16),
+		request: r,
+		expires: time.Now().Add(idempotencyKeyTTL),
+		done:    make(chan struct{}),
+	}
+	k.m[clientID+"/"+key] = sub
+
+	return sub, true, nil
+}
+
+// complete records the outcome of a reserved submission. Failed submissions
+// give up their key so they can be retried
+func (k *idempotencyKeys) complete(clientID, key string, sub *idempotentSubmission, err error) {
+	k.l.Lock()
+	defer k.l.Unlock()
+
+	sub.err = err
+	close(sub.done)
+
+	if err != nil && k.m[clientID+"/"+key] == sub {
+		delete(k.m, clientID+"/"+key)
+	}
+}
+
+// wait returns the lease request or gang ID of a submission once it has
+// completed
+func (sub *idempotentSubmission) wait(ctx context.Context) (string, error) {
+	select {
+	case <-sub.done:
+	case <-ctx.Done():
+		return "", status.FromContextError(ctx.Err()).Err()
+	}
+
+	if sub.err != nil {
+		return "", sub.err
+	}
+
+	return sub.id, nil
+}
+
+// prune forgets expired idempotency keys
+func (k *idempotencyKeys) prune(now time.Time) {
+	k.l.Lock()
+	defer k.l.Unlock()
+
+	for key, sub := range k.m {
+		if sub.expires.Before(now) {
+			delete(k.m, key)
+		}
+	}
+}
+
+// forgetClient forgets the idempotency keys of a deregistered client
+func (k *idempotencyKeys) forgetClient(clientID string) {
+	k.l.Lock()
+	defer k.l.Unlock()
+
+	for key := range k.m {
+		if strings.HasPrefix(key, clientID+"/") {
+			delete(k.m, key)
+		}
+	}
+}
+
+// submitIdempotent submits a lease request or gang with an idempotency key,
+// returning the ID previously submitted with the same key if there is one
+func (s *Server) submitIdempotent(ctx context.Context, clientID, key string, request interface{}, submit func(id string) error) (string, error) {
+	sub, created, err := s.idempotency.reserve(clientID, key, request)
+	if err != nil {
+		return "", err
+	}
+
+	if !created {
+		s.log.Printf("Deduped submission with idempotency key %q to %s", key, sub.id)
+		return sub.wait(ctx)
+	}
+
+	err = submit(sub.id)
+	s.idempotency.complete(clientID, key, sub, err)
+
+	return sub.id, err
+}
@@ -0,0 +1,168 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/p0pr0ck5/volchestrator/lease"
+	svc "github.com/p0pr0ck5/volchestrator/svc"
+)
+
+// leaseRequestCurrent reports whether a lease request is still pending as
+// given, and was neither cancelled nor updated since it was listed
+func (s *Server) leaseRequestCurrent(request *lease.LeaseRequest) bool {
+	current, err := s.b.GetLeaseRequest(request.LeaseRequestID)
+	if err != nil {
+		s.log.Println(err)
+		return false
+	}
+
+	return current != nil && current.Revision == request.Revision
+}
+
+// ownedLeaseRequests returns the lease requests with a given ID, or of a gang
+// with a given ID, verifying they belong to a given client
+func (s *Server) ownedLeaseRequests(clientID, id string) ([]*lease.LeaseRequest, error) {
+	request, err := s.b.GetLeaseRequest(id)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := []*lease.LeaseRequest{}
+	if request != nil {
+		requests = append(requests, request)
+	} else {
+		requests, err = s.b.ListLeaseRequests(lease.LeaseRequestFilterByGang(id))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(requests) == 0 {
+		return nil, status.Errorf(codes.NotFound, "lease request %q not found", id)
+	}
+
+	if requests[0].ClientID != clientID {
+		return nil, status.Errorf(codes.PermissionDenied, "lease request %q is not owned by client %q", id, clientID)
+	}
+
+	return requests, nil
+}
+
+// offerError converts an error from withdrawing offers to a gRPC status
+func offerError(id string, err error) error {
+	switch {
+	case errors.Is(err, errOfferClaimed):
+		return status.Errorf(codes.FailedPrecondition, "lease request %q has already accepted a volume offer", id)
+	case errors.Is(err, errOfferPending):
+		return status.Errorf(codes.FailedPrecondition, "lease request %q has a pending volume offer", id)
+	}
+
+	return err
+}
+
+// CancelLeaseRequest withdraws a pending lease request, or all the lease
+// requests of a gang. A volume offer awaiting the client's ack is withdrawn
+// with it, but a request whose offer was already acked can't be cancelled,
+// as its lease is being created
+func (s *Server) CancelLeaseRequest(ctx context.Context, msg *svc.CancelLeaseRequestMessage) (*svc.Empty, error) {
+	requests, err := s.ownedLeaseRequests(msg.ClientId, msg.LeaseRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	// gang lease requests only make sense together
+	if requests[0].GangID != "" && requests[0].GangID != msg.LeaseRequestId {
+		return nil, status.Errorf(codes.FailedPrecondition, "lease request %q belongs to gang %q", msg.LeaseRequestId, requests[0].GangID)
+	}
+
+	ids := []string{}
+	for _, request := range requests {
+		ids = append(ids, request.LeaseRequestID)
+	}
+
+	err = s.offers.withdraw(ids, true, func() error {
+		for _, request := range requests {
+			err := s.b.DeleteLeaseRequest(request.LeaseRequestID)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, offerError(msg.LeaseRequestId, err)
+	}
+
+	for _, request := range requests {
+		s.log.Println("Cancelled lease request", request.LeaseRequestID)
+		s.recordLeaseRequest(request, "", lease.HistoryStateCancelled, "cancelled by client")
+	}
+
+	return &svc.Empty{}, nil
+}
+
+// UpdateLeaseRequest replaces the volume selector and priority of a pending
+// lease request. Requests with a volume offer in flight can't be updated
+// until the offer is resolved, and gang lease requests can't be updated
+func (s *Server) UpdateLeaseRequest(ctx context.Context, msg *svc.UpdateLeaseRequestMessage) (*svc.Empty, error) {
+	request, err := s.b.GetLeaseRequest(msg.LeaseRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	if request == nil {
+		return nil, status.Errorf(codes.NotFound, "lease request %q not found", msg.LeaseRequestId)
+	}
+
+	if request.ClientID != msg.ClientId {
+		return nil, status.Errorf(codes.PermissionDenied, "lease request %q is not owned by client %q", msg.LeaseRequestId, msg.ClientId)
+	}
+
+	if request.GangID != "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "lease request %q belongs to gang %q", msg.LeaseRequestId, request.GangID)
+	}
+
+	if msg.VolumeId != "" {
+		volume, err := s.b.GetVolume(msg.VolumeId)
+		if err != nil {
+			return nil, err
+		}
+
+		if volume == nil {
+			return nil, status.Errorf(codes.NotFound, "volume %q not found", msg.VolumeId)
+		}
+	}
+
+	// the scheduler may hold the old request, so it is replaced rather than
+	// changed in place
+	updated := *request
+	updated.Revision++
+	updated.VolumeTag = msg.Tag
+	updated.VolumeAvailabilityZone = msg.AvailabilityZone
+	updated.VolumeID = msg.VolumeId
+	updated.Priority = int(msg.Priority)
+
+	err = s.checkLeaseRequestQuota(msg.ClientId, []*lease.LeaseRequest{&updated})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.offers.withdraw([]string{request.LeaseRequestID}, false, func() error {
+		return s.b.UpdateLeaseRequest(&updated)
+	})
+	if err != nil {
+		return nil, offerError(msg.LeaseRequestId, err)
+	}
+
+	s.log.Println("Updated lease request", request.LeaseRequestID)
+	s.recordLeaseRequest(&updated, "", lease.HistoryStateUpdated, "updated by client")
+
+	go s.iterateLeaseRequests()
+
+	return &svc.Empty{}, nil
+}
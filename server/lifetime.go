@@ -0,0 +1,143 @@
+package server
+
+import (
+	"sort"
+	"time"
+
+	"github.com/p0pr0ck5/volchestrator/lease"
+)
+
+// lifetimes holds the maximum time a client may hold a volume, regardless of
+// renewals, and when holders are warned before that time is reached
+type lifetimes struct {
+	def  time.Duration
+	tags map[string]time.Duration
+
+	// warnings are sorted from furthest to closest to the deadline
+	warnings []time.Duration
+}
+
+func newLifetimes(def time.Duration, tags map[string]time.Duration, warnings []time.Duration) *lifetimes {
+	sorted := append([]time.Duration{}, warnings...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] > sorted[j]
+	})
+
+	return &lifetimes{
+		def:      def,
+		tags:     tags,
+		warnings: sorted,
+	}
+}
+
+// limit returns the maximum lifetime of a lease of a given volume. The
+// shortest limit of the volume's tags applies, falling back to the global
+// limit. Zero means unlimited
+func (l *lifetimes) limit(volume *Volume) time.Duration {
+	var limit time.Duration
+	for _, tag := range volume.Tags {
+		d, ok := l.tags[tag]
+		if !ok {
+			continue
+		}
+
+		if limit == 0 || d < limit {
+			limit = d
+		}
+	}
+
+	if limit == 0 {
+		limit = l.def
+	}
+
+	return limit
+}
+
+// startLifetime marks a lease as newly acquired by its current client, and
+// sets its deadline if its volume has a maximum lifetime
+func (s *Server) startLifetime(l *lease.Lease, volume *Volume) {
+	now := time.Now()
+
+	l.Acquired = now
+	l.Deadline = time.Time{}
+	l.LifetimeWarnings = 0
+
+	if s.lifetimes == nil {
+		return
+	}
+
+	if limit := s.lifetimes.limit(volume); limit > 0 {
+		l.Deadline = now.Add(limit)
+	}
+}
+
+// enforceLeaseLifetimes warns the holders of leases approaching their
+// deadline, and releases leases that have reached it
+func (s *Server) enforceLeaseLifetimes() {
+	if s.lifetimes == nil {
+		return
+	}
+
+	leases, err := s.b.ListLeases(lease.LeaseFilterAll)
+	if err != nil {
+		s.log.Println(err)
+		return
+	}
+
+	now := time.Now()
+
+	releasedGangs := make(map[string]bool)
+
+	for _, l := range leases {
+		if l.Status != lease.LeaseStatusAssigned && l.Status != lease.LeaseStatusOrphaned {
+			continue
+		}
+
+		if l.Deadline.IsZero() {
+			continue
+		}
+
+		remaining := l.Deadline.Sub(now)
+
+		if remaining <= 0 {
+			// gang leases are released together
+			if l.GangID != "" {
+				if releasedGangs[l.GangID] {
+					continue
+				}
+				releasedGangs[l.GangID] = true
+			}
+
+			err := s.releaseWithGang(l, "maximum lifetime reached")
+			if err != nil {
+				s.log.Println(err)
+			}
+			continue
+		}
+
+		warned := l.LifetimeWarnings
+		for l.LifetimeWarnings < len(s.lifetimes.warnings) && remaining <= s.lifetimes.warnings[l.LifetimeWarnings] {
+			l.LifetimeWarnings++
+		}
+
+		if l.LifetimeWarnings == warned {
+			continue
+		}
+
+		err := s.b.UpdateLease(l)
+		if err != nil {
+			s.log.Println(err)
+			continue
+		}
+
+		s.log.Println("Lease", l.LeaseID, "reaches its maximum lifetime in", remaining)
+
+		s.writeNotification(l.ClientID, NewNotification(
+			LeaseLifetimeWarningNotificationType,
+			LeaseLifetimeWarningPayload{
+				LeaseID:  l.LeaseID,
+				Deadline: l.Deadline,
+			},
+		))
+	}
+}
@@ -1,7 +1,15 @@
 package server
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
 	"github.com/thanhpk/randstr"
+
+	"github.com/p0pr0ck5/volchestrator/lease"
+	svc "github.com/p0pr0ck5/volchestrator/svc"
 )
 
 // NotificationType defines the type of notification sent to the client
@@ -24,20 +32,318 @@ const (
 
 	// LeaseNotificationType is an announcement that a lease has been allocated to a client
 	LeaseNotificationType
+
+	// LeaseRevokedNotificationType is an announcement that a lease will be revoked in favor
+	// of a higher priority lease request once a grace period has passed
+	LeaseRevokedNotificationType
+
+	// LeaseReleasedNotificationType is a confirmation that a lease released by the client,
+	// or by the server once its maximum lifetime was reached, has been fully released
+	LeaseReleasedNotificationType
+
+	// LeaseTransferredNotificationType is a confirmation that a lease has been handed to
+	// another client
+	LeaseTransferredNotificationType
+
+	// LeaseLifetimeWarningNotificationType is an announcement that a lease is approaching
+	// its maximum lifetime and will be released regardless of renewals
+	LeaseLifetimeWarningNotificationType
+
+	// LeaseExpiringNotificationType is an announcement that a lease or lease request
+	// is close to expiring and should be renewed
+	LeaseExpiringNotificationType
 )
 
 // Notification is a message to be passed to the client
 type Notification struct {
 	ID      string
 	Type    NotificationType
-	Message string
+	Payload NotificationPayload
+
+	// Sequence orders a client's notifications, and is assigned by the Backend
+	// when the notification is written
+	Sequence uint64
 }
 
-// NewNotification returns a new Notification with a given type and message
-func NewNotification(t NotificationType, message string) Notification {
+// ErrNotificationCursorCompacted is returned when a client resumes watching
+// notifications after a sequence number that is no longer in the backend's
+// history, so missed notifications cannot be replayed
+var ErrNotificationCursorCompacted = errors.New("notification cursor is no longer available")
+
+// ErrNotificationBufferFull is returned when a notification is dropped because
+// its client's notification buffer is full
+var ErrNotificationBufferFull = errors.New("notification buffer is full")
+
+// ErrNotificationStreamDropped is returned to a client's notification stream
+// when it is disconnected because the client's notification buffer overflowed
+var ErrNotificationStreamDropped = errors.New("notification stream dropped as the client is not keeping up")
+
+// OverflowPolicy decides what happens to a notification written for a client
+// whose notification buffer is full
+type OverflowPolicy int
+
+const (
+	// OverflowDropStream discards the client's buffer and disconnects its stream,
+	// so it resumes from its cursor
+	OverflowDropStream OverflowPolicy = iota
+
+	// OverflowCoalesce discards buffered notifications superseded by the new one,
+	// and drops the stream as with OverflowDropStream if that isn't enough
+	OverflowCoalesce
+
+	// OverflowMarkUnhealthy marks the client unhealthy and drops new notifications
+	// until it has caught up
+	OverflowMarkUnhealthy
+)
+
+// ParseOverflowPolicy returns the OverflowPolicy with a given config name
+func ParseOverflowPolicy(s string) (OverflowPolicy, error) {
+	switch s {
+	case "drop_stream":
+		return OverflowDropStream, nil
+	case "coalesce":
+		return OverflowCoalesce, nil
+	case "mark_unhealthy":
+		return OverflowMarkUnhealthy, nil
+	}
+
+	return 0, fmt.Errorf("invalid overflow policy %q", s)
+}
+
+// NotificationStats counts what happened to a client's notifications
+type NotificationStats struct {
+	ClientID string
+
+	// Pending is the number of notifications waiting to be acked
+	Pending int
+
+	Written     uint64
+	Delivered   uint64
+	Redelivered uint64
+
+	// Dropped notifications were discarded without being acked, Coalesced ones
+	// were superseded by a newer notification
+	Dropped        uint64
+	Coalesced      uint64
+	StreamsDropped uint64
+}
+
+// NewNotification returns a new Notification with a given type and payload
+func NewNotification(t NotificationType, payload NotificationPayload) Notification {
 	return Notification{
 		ID:      randstr.Hex(16),
 		Type:    t,
-		Message: message,
+		Payload: payload,
+	}
+}
+
+// toProto converts a Notification to its gRPC representation
+func (n Notification) toProto() *svc.Notification {
+	p := &svc.Notification{
+		Id:       n.ID,
+		Type:     svc.NotificationType(n.Type),
+		Sequence: n.Sequence,
+	}
+
+	if n.Payload != nil {
+		n.Payload.toProto(p)
+	}
+
+	return p
+}
+
+// CoalesceKey identifies what a notification is about, such that a newer
+// notification with the same key supersedes an older one. Notifications that
+// are never superseded have an empty key
+func (n Notification) CoalesceKey() string {
+	switch p := n.Payload.(type) {
+	case ClientRegisteredPayload:
+		return "registered"
+	case LeaseLifetimeWarningPayload:
+		return "lifetime:" + p.LeaseID
+	case LeaseExpiringPayload:
+		return "expiring:" + p.LeaseID + p.LeaseRequestID + p.GangID
+	}
+
+	return ""
+}
+
+// NotificationPayload is the typed content of a Notification
+type NotificationPayload interface {
+	toProto(*svc.Notification)
+}
+
+// ClientRegisteredPayload is sent to a client once it registers
+type ClientRegisteredPayload struct {
+	ClientID string
+}
+
+func (p ClientRegisteredPayload) toProto(n *svc.Notification) {
+	n.Payload = &svc.Notification_ClientRegistered{
+		ClientRegistered: &svc.ClientRegisteredPayload{
+			ClientId: p.ClientID,
+		},
+	}
+}
+
+// LeaseRequestAckPayload acknowledges a lease request, or a gang lease request
+// in which case only GangID is set
+type LeaseRequestAckPayload struct {
+	LeaseRequestID string
+	GangID         string
+}
+
+func (p LeaseRequestAckPayload) toProto(n *svc.Notification) {
+	n.Payload = &svc.Notification_LeaseRequestAck{
+		LeaseRequestAck: &svc.LeaseRequestAckPayload{
+			LeaseRequestId: p.LeaseRequestID,
+			GangId:         p.GangID,
+		},
+	}
+}
+
+// LeaseRequestExpiredPayload identifies an expired lease request, or gang lease
+// request in which case only GangID is set
+type LeaseRequestExpiredPayload struct {
+	LeaseRequestID string
+	GangID         string
+}
+
+func (p LeaseRequestExpiredPayload) toProto(n *svc.Notification) {
+	n.Payload = &svc.Notification_LeaseRequestExpired{
+		LeaseRequestExpired: &svc.LeaseRequestExpiredPayload{
+			LeaseRequestId: p.LeaseRequestID,
+			GangId:         p.GangID,
+		},
+	}
+}
+
+// LeaseAvailablePayload offers volumes to a lease request, or to every member
+// of a gang lease request in which case only GangID is set
+type LeaseAvailablePayload struct {
+	LeaseRequestID string
+	GangID         string
+	VolumeIDs      []string
+}
+
+func (p LeaseAvailablePayload) toProto(n *svc.Notification) {
+	n.Payload = &svc.Notification_LeaseAvailable{
+		LeaseAvailable: &svc.LeaseAvailablePayload{
+			LeaseRequestId: p.LeaseRequestID,
+			GangId:         p.GangID,
+			VolumeIds:      p.VolumeIDs,
+		},
+	}
+}
+
+// LeaseGrantedPayload holds a lease as it was when granted to a client. Gangs
+// are granted together, with all their leases in GangLeases instead
+type LeaseGrantedPayload struct {
+	Lease lease.Lease
+
+	GangID     string
+	GangLeases []lease.Lease
+}
+
+func (p LeaseGrantedPayload) toProto(n *svc.Notification) {
+	payload := &svc.LeaseGrantedPayload{
+		GangId: p.GangID,
+	}
+
+	if p.GangID == "" {
+		payload.Lease = leaseProto(&p.Lease)
+	}
+
+	for i := range p.GangLeases {
+		payload.GangLeases = append(payload.GangLeases, leaseProto(&p.GangLeases[i]))
+	}
+
+	n.Payload = &svc.Notification_LeaseGranted{
+		LeaseGranted: payload,
+	}
+}
+
+// LeaseRevokedPayload identifies a lease that will be revoked once its grace
+// period has passed
+type LeaseRevokedPayload struct {
+	LeaseID     string
+	GracePeriod time.Duration
+}
+
+func (p LeaseRevokedPayload) toProto(n *svc.Notification) {
+	n.Payload = &svc.Notification_LeaseRevoked{
+		LeaseRevoked: &svc.LeaseRevokedPayload{
+			LeaseId:     p.LeaseID,
+			GracePeriod: ptypes.DurationProto(p.GracePeriod),
+		},
+	}
+}
+
+// LeaseReleasedPayload identifies a released lease and why it was released
+type LeaseReleasedPayload struct {
+	LeaseID string
+	Reason  string
+}
+
+func (p LeaseReleasedPayload) toProto(n *svc.Notification) {
+	n.Payload = &svc.Notification_LeaseReleased{
+		LeaseReleased: &svc.LeaseReleasedPayload{
+			LeaseId: p.LeaseID,
+			Reason:  p.Reason,
+		},
+	}
+}
+
+// LeaseTransferredPayload identifies a lease handed to another client
+type LeaseTransferredPayload struct {
+	LeaseID        string
+	TargetClientID string
+}
+
+func (p LeaseTransferredPayload) toProto(n *svc.Notification) {
+	n.Payload = &svc.Notification_LeaseTransferred{
+		LeaseTransferred: &svc.LeaseTransferredPayload{
+			LeaseId:        p.LeaseID,
+			TargetClientId: p.TargetClientID,
+		},
+	}
+}
+
+// LeaseLifetimeWarningPayload identifies a lease approaching its maximum lifetime
+type LeaseLifetimeWarningPayload struct {
+	LeaseID  string
+	Deadline time.Time
+}
+
+func (p LeaseLifetimeWarningPayload) toProto(n *svc.Notification) {
+	deadline, _ := ptypes.TimestampProto(p.Deadline)
+
+	n.Payload = &svc.Notification_LeaseLifetimeWarning{
+		LeaseLifetimeWarning: &svc.LeaseLifetimeWarningPayload{
+			LeaseId:  p.LeaseID,
+			Deadline: deadline,
+		},
+	}
+}
+
+// LeaseExpiringPayload identifies a lease, lease request or gang lease request
+// that is about to expire. Exactly one of the IDs is set
+type LeaseExpiringPayload struct {
+	LeaseID        string
+	LeaseRequestID string
+	GangID         string
+	Expires        time.Time
+}
+
+func (p LeaseExpiringPayload) toProto(n *svc.Notification) {
+	expires, _ := ptypes.TimestampProto(p.Expires)
+
+	n.Payload = &svc.Notification_LeaseExpiring{
+		LeaseExpiring: &svc.LeaseExpiringPayload{
+			LeaseId:        p.LeaseID,
+			LeaseRequestId: p.LeaseRequestID,
+			GangId:         p.GangID,
+			Expires:        expires,
+		},
 	}
 }
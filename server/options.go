@@ -0,0 +1,80 @@
+package server
+
+import (
+	"time"
+
+	"github.com/p0pr0ck5/volchestrator/config"
+)
+
+// Option configures optional Server behavior
+type Option func(*Server)
+
+// WithEventHandler calls a given function with every event the server emits.
+// The function is called synchronously, so it must not block
+func WithEventHandler(h func(Event)) Option {
+	return func(s *Server) {
+		s.eventHandlers = append(s.eventHandlers, h)
+	}
+}
+
+// WithQuotas limits the leases and lease requests each client may hold
+func WithQuotas(c config.QuotaConfig) Option {
+	return func(s *Server) {
+		s.quotas = newQuotas(c)
+	}
+}
+
+// WithPreemption revokes leases whose priority is at least margin lower than
+// a waiting lease request, after giving the holder a grace period to release.
+// Margins below 1 are raised to 1, so leases are never preempted by requests
+// of the same priority, which could then be preempted back
+func WithPreemption(margin int, gracePeriod time.Duration) Option {
+	if margin < 1 {
+		margin = 1
+	}
+
+	return func(s *Server) {
+		s.preemption = &preemption{
+			margin:      margin,
+			gracePeriod: gracePeriod,
+			leases:      &lrm{m: make(map[string]bool)},
+			requests:    &lrm{m: make(map[string]bool)},
+		}
+	}
+}
+
+// WithLeaseTTL sets the TTL given to leases and lease requests that don't
+// request one, and the bounds for those that do. Zero bounds are unlimited
+func WithLeaseTTL(def, min, max time.Duration) Option {
+	return func(s *Server) {
+		s.ttl = ttlBounds{
+			def: def,
+			min: min,
+			max: max,
+		}
+	}
+}
+
+// WithDeadClientGracePeriod holds the leases of dead clients for a given period,
+// restoring them if the client comes back before it elapses
+func WithDeadClientGracePeriod(d time.Duration) Option {
+	return func(s *Server) {
+		s.deadClientGracePeriod = d
+	}
+}
+
+// WithMaxLeaseLifetime releases leases once they have been held for a maximum
+// lifetime, warning the holder at each of the given durations beforehand
+func WithMaxLeaseLifetime(lifetime time.Duration, tags map[string]time.Duration, warnings []time.Duration) Option {
+	return func(s *Server) {
+		s.lifetimes = newLifetimes(lifetime, tags, warnings)
+	}
+}
+
+// WithExpiryWarning warns clients when a lease or lease request has less than
+// a given fraction of its TTL left
+func WithExpiryWarning(fraction float64) Option {
+	return func(s *Server) {
+		s.expiryWarningFraction = fraction
+	}
+}
@@ -0,0 +1,162 @@
+package server
+
+import (
+	"time"
+
+	"github.com/p0pr0ck5/volchestrator/lease"
+)
+
+// preemption tracks leases being revoked in favor of higher priority requests
+type preemption struct {
+	margin      int
+	gracePeriod time.Duration
+
+	// leases being revoked, and the requests they are being revoked for
+	leases   *lrm
+	requests *lrm
+}
+
+// preemptLeases looks for lease requests that cannot be satisfied by any
+// available volume, and starts revoking the lowest priority lease on a
+// matching volume if the request outranks it by the configured margin
+func (s *Server) preemptLeases(available []*Volume, requests []*lease.LeaseRequest) {
+	leases, err := s.b.ListLeases(lease.LeaseFilterAll)
+	if err != nil {
+		s.log.Println(err)
+		return
+	}
+
+	for _, request := range requests {
+		satisfiable := false
+		for _, volume := range available {
+			if matchLeaseRequest(volume, request) {
+				satisfiable = true
+				break
+			}
+		}
+
+		if satisfiable {
+			continue
+		}
+
+		var victim *lease.Lease
+		for _, l := range leases {
+			// gang leases are only released together, and revoking a shared lease
+			// doesn't free its volume, so neither are preempted
+			if l.Status != lease.LeaseStatusAssigned || l.GangID != "" || l.AccessMode != lease.AccessModeExclusive ||
+				l.ClientID == request.ClientID {
+				continue
+			}
+
+			if request.Priority-l.Priority < s.preemption.margin {
+				continue
+			}
+
+			if victim != nil && victim.Priority <= l.Priority {
+				continue
+			}
+
+			volume, err := s.b.GetVolume(l.VolumeID)
+			if err != nil || volume == nil {
+				continue
+			}
+
+			if !matchLeaseRequest(volume, request) || s.checkLeaseQuota(request.ClientID, volume) != nil {
+				continue
+			}
+
+			victim = l
+		}
+
+		if victim == nil {
+			continue
+		}
+
+		if !s.preemption.requests.mark(request.LeaseRequestID) {
+			continue
+		}
+
+		if !s.preemption.leases.mark(victim.LeaseID) {
+			s.preemption.requests.unmark(request.LeaseRequestID)
+			continue
+		}
+
+		go s.preemptLease(victim, request)
+	}
+}
+
+// preemptLease notifies the holder of a lease that it is being revoked, waits
+// for it to be released voluntarily, and otherwise releases it once the grace
+// period has passed. Releasing the lease triggers an iteration of lease requests,
+// which offers the volume to the highest priority request first
+func (s *Server) preemptLease(l *lease.Lease, request *lease.LeaseRequest) {
+	defer s.preemption.leases.unmark(l.LeaseID)
+	defer s.preemption.requests.unmark(request.LeaseRequestID)
+
+	s.log.Println("Preempting lease", l.LeaseID, "for", request.LeaseRequestID)
+
+	// the backend may hand out the lease itself, so its holder and the
+	// request's revision are remembered as they were when it was preempted
+	holder := l.ClientID
+	revision := request.Revision
+
+	s.writeNotification(l.ClientID, NewNotification(
+		LeaseRevokedNotificationType,
+		LeaseRevokedPayload{
+			LeaseID:     l.LeaseID,
+			GracePeriod: s.preemption.gracePeriod,
+		},
+	))
+
+	deadline := time.After(s.preemption.gracePeriod)
+	t := time.NewTicker(time.Second)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+			current, err := s.b.GetLease(l.LeaseID)
+			if err != nil {
+				s.log.Println(err)
+				continue
+			}
+
+			if current == nil || current.Status == lease.LeaseStatusReleasing {
+				s.log.Println("Lease", l.LeaseID, "released before revocation")
+				return
+			}
+		case <-deadline:
+			current, err := s.b.GetLease(l.LeaseID)
+			if err != nil {
+				s.log.Println(err)
+				return
+			}
+
+			if current == nil || current.Status != lease.LeaseStatusAssigned || current.ClientID != holder {
+				s.log.Println("Lease", l.LeaseID, "changed before revocation, not revoking it")
+				return
+			}
+
+			// nobody may be waiting for the volume anymore
+			waiting, err := s.b.GetLeaseRequest(request.LeaseRequestID)
+			if err != nil {
+				s.log.Println(err)
+				return
+			}
+
+			if waiting == nil || waiting.Revision != revision {
+				s.log.Println("Lease request", request.LeaseRequestID, "changed before revocation, not revoking", l.LeaseID)
+				return
+			}
+
+			s.log.Println("Revoking lease", l.LeaseID)
+
+			err = s.releaseLease(current, "preempted")
+			if err != nil {
+				s.log.Println(err)
+			}
+
+			return
+		}
+	}
+}
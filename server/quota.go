@@ -0,0 +1,266 @@
+package server
+
+import (
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/p0pr0ck5/volchestrator/config"
+	"github.com/p0pr0ck5/volchestrator/lease"
+)
+
+// quotaLimit is the maximum number of leases and pending lease requests a
+// client may hold in a given scope, zero values mean no limit
+type quotaLimit struct {
+	maxLeases          int
+	maxPendingRequests int
+}
+
+func maxLeases(l quotaLimit) int {
+	return l.maxLeases
+}
+
+func maxPendingRequests(l quotaLimit) int {
+	return l.maxPendingRequests
+}
+
+// quotas holds the per-client limits overall, by tag, and by availability zone
+type quotas struct {
+	client quotaLimit
+	tags   map[string]quotaLimit
+	azs    map[string]quotaLimit
+
+	// l serializes lease creation against the lease quotas
+	l sync.Mutex
+}
+
+func newQuotas(c config.QuotaConfig) *quotas {
+	q := &quotas{
+		client: quotaLimit{
+			maxLeases:          c.MaxLeases,
+			maxPendingRequests: c.MaxPendingRequests,
+		},
+		tags: make(map[string]quotaLimit),
+		azs:  make(map[string]quotaLimit),
+	}
+
+	for _, t := range c.Tags {
+		q.tags[t.Name] = quotaLimit{
+			maxLeases:          t.MaxLeases,
+			maxPendingRequests: t.MaxPendingRequests,
+		}
+	}
+
+	for _, az := range c.AvailabilityZones {
+		q.azs[az.Name] = quotaLimit{
+			maxLeases:          az.MaxLeases,
+			maxPendingRequests: az.MaxPendingRequests,
+		}
+	}
+
+	return q
+}
+
+// exceeded returns an error describing the first scope in which the given
+// usage is over its limit, or nil if every scope is within its limit
+func (q *quotas) exceeded(u *quotaUsage, limit func(quotaLimit) int, kind string) error {
+	if max := limit(q.client); max > 0 && u.total > max {
+		return fmt.Errorf("%s quota of %d exceeded", kind, max)
+	}
+
+	for tag, n := range u.tags {
+		if max := limit(q.tags[tag]); max > 0 && n > max {
+			return fmt.Errorf("%s quota of %d exceeded for tag %q", kind, max, tag)
+		}
+	}
+
+	for az, n := range u.azs {
+		if max := limit(q.azs[az]); max > 0 && n > max {
+			return fmt.Errorf("%s quota of %d exceeded for availability zone %q", kind, max, az)
+		}
+	}
+
+	return nil
+}
+
+// quotaUsage counts the items a client holds in each quota scope
+type quotaUsage struct {
+	total int
+	tags  map[string]int
+	azs   map[string]int
+}
+
+func newQuotaUsage() *quotaUsage {
+	return &quotaUsage{
+		tags: make(map[string]int),
+		azs:  make(map[string]int),
+	}
+}
+
+func (u *quotaUsage) add(tags []string, az string) {
+	u.total++
+
+	for _, tag := range tags {
+		u.tags[tag]++
+	}
+
+	if az != "" {
+		u.azs[az]++
+	}
+}
+
+// leaseRequestScopes returns the tags and availability zone a LeaseRequest
+// counts against. Requests for a specific volume count against that volume's
+// tags and availability zone
+func (s *Server) leaseRequestScopes(request *lease.LeaseRequest) ([]string, string, error) {
+	if request.VolumeID == "" {
+		return []string{request.VolumeTag}, request.VolumeAvailabilityZone, nil
+	}
+
+	volume, err := s.b.GetVolume(request.VolumeID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if volume == nil {
+		return nil, "", nil
+	}
+
+	return volume.Tags, volume.AvailabilityZone, nil
+}
+
+func (s *Server) leaseUsage(clientID string) (*quotaUsage, error) {
+	leases, err := s.b.ListLeases(lease.LeaseFilterByClient(clientID))
+	if err != nil {
+		return nil, err
+	}
+
+	u := newQuotaUsage()
+
+	for _, l := range leases {
+		volume, err := s.b.GetVolume(l.VolumeID)
+		if err != nil {
+			return nil, err
+		}
+
+		if volume == nil {
+			u.add(nil, "")
+			continue
+		}
+
+		u.add(volume.Tags, volume.AvailabilityZone)
+	}
+
+	return u, nil
+}
+
+// leaseRequestUsage counts a client's pending lease requests, except those
+// with given IDs
+func (s *Server) leaseRequestUsage(clientID string, ignore map[string]bool) (*quotaUsage, error) {
+	requests, err := s.b.ListLeaseRequests(lease.LeaseRequestFilterByClient(clientID))
+	if err != nil {
+		return nil, err
+	}
+
+	u := newQuotaUsage()
+
+	for _, request := range requests {
+		if ignore[request.LeaseRequestID] {
+			continue
+		}
+
+		tags, az, err := s.leaseRequestScopes(request)
+		if err != nil {
+			return nil, err
+		}
+
+		u.add(tags, az)
+	}
+
+	return u, nil
+}
+
+// checkLeaseRequestQuota verifies a client may submit the given LeaseRequests,
+// returning a ResourceExhausted error if it would hold too many pending requests,
+// or if it already holds as many leases as the requests could ever be granted.
+// Requests replacing pending ones with the same ID are only counted once
+func (s *Server) checkLeaseRequestQuota(clientID string, requests []*lease.LeaseRequest) error {
+	if s.quotas == nil {
+		return nil
+	}
+
+	replaced := make(map[string]bool)
+	for _, request := range requests {
+		replaced[request.LeaseRequestID] = true
+	}
+
+	pending, err := s.leaseRequestUsage(clientID, replaced)
+	if err != nil {
+		return err
+	}
+
+	leases, err := s.leaseUsage(clientID)
+	if err != nil {
+		return err
+	}
+
+	for _, request := range requests {
+		tags, az, err := s.leaseRequestScopes(request)
+		if err != nil {
+			return err
+		}
+
+		pending.add(tags, az)
+		leases.add(tags, az)
+	}
+
+	if err := s.quotas.exceeded(pending, maxPendingRequests, "pending lease request"); err != nil {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+
+	if err := s.quotas.exceeded(leases, maxLeases, "lease"); err != nil {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+
+	return nil
+}
+
+// checkLeaseQuota verifies a client may be granted leases on the given volumes
+func (s *Server) checkLeaseQuota(clientID string, volumes ...*Volume) error {
+	if s.quotas == nil {
+		return nil
+	}
+
+	leases, err := s.leaseUsage(clientID)
+	if err != nil {
+		return err
+	}
+
+	for _, volume := range volumes {
+		leases.add(volume.Tags, volume.AvailabilityZone)
+	}
+
+	return s.quotas.exceeded(leases, maxLeases, "lease")
+}
+
+// lockLeaseQuota checks again that a client may be granted leases on the given
+// volumes, and holds off other lease creation until the returned function is
+// called, so offers claimed at the same time can't take the client over its
+// quota together. The leases must be added before unlocking
+func (s *Server) lockLeaseQuota(clientID string, volumes ...*Volume) (func(), error) {
+	if s.quotas == nil {
+		return func() {}, nil
+	}
+
+	s.quotas.l.Lock()
+
+	err := s.checkLeaseQuota(clientID, volumes...)
+	if err != nil {
+		s.quotas.l.Unlock()
+		return nil, err
+	}
+
+	return s.quotas.l.Unlock, nil
+}
@@ -2,15 +2,19 @@ package server
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"os"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/thanhpk/randstr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/p0pr0ck5/volchestrator/lease"
 	svc "github.com/p0pr0ck5/volchestrator/svc"
@@ -30,17 +34,50 @@ type Server struct {
 
 	iterateWatch chan struct{}
 
+	offers *offerMap
+
+	reservations *volumeReservations
+
+	ttl ttlBounds
+
+	quotas *quotas
+
+	preemption *preemption
+
+	deadClientGracePeriod time.Duration
+
+	lifetimes *lifetimes
+
+	expiryWarningFraction float64
+
+	events *eventBus
+
+	eventHandlers []func(Event)
+
+	idempotency *idempotencyKeys
+
 	log *log.Logger
 }
 
 // NewServer creates a new Server with a given Backend
-func NewServer(b Backend, r ResourceManager) *Server {
-	return &Server{
+func NewServer(b Backend, r ResourceManager, opts ...Option) *Server {
+	s := &Server{
 		b:            b,
 		r:            r,
 		iterateWatch: make(chan struct{}),
+		offers:       &offerMap{m: make(map[string]*offer)},
+		reservations: newVolumeReservations(),
+		ttl:          ttlBounds{def: lease.DefaultLeaseTTL},
+		events:       newEventBus(),
+		idempotency:  newIdempotencyKeys(),
 		log:          log.New(os.Stdout, "", log.Ldate|log.Ltime|log.Lmicroseconds|log.Lshortfile),
 	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
 // Init starts background routines
@@ -57,6 +94,19 @@ func (s *Server) Init() {
 		}
 	}()
 
+	if s.expiryWarningFraction > 0 {
+		go func() {
+			t := time.NewTicker(time.Second)
+
+			for {
+				select {
+				case <-t.C:
+					s.warnExpiring()
+				}
+			}
+		}()
+	}
+
 	go s.watchLeaseRequestIterations()
 }
 
@@ -75,17 +125,98 @@ func (s *Server) pruneClients() {
 		}
 	}
 
+	// unhealthy clients are still alive, just behind on their notifications
 	aliveClients, err := s.b.Clients(ClientFilterByStatus(AliveClientStatus))
 	if err != nil {
 		s.log.Println(err)
 	}
-	for _, client := range aliveClients {
+	unhealthyClients, err := s.b.Clients(ClientFilterByStatus(UnhealthyClientStatus))
+	if err != nil {
+		s.log.Println(err)
+	}
+	for _, client := range append(aliveClients, unhealthyClients...) {
 		d := now.Sub(client.LastSeen)
 		if d > time.Second*heartbeatTTL {
 			s.log.Printf("Marking %s as dead with diff %v", client.ID, d)
 			s.b.UpdateClient(client.ID, DeadClientStatus)
+
+			s.emit(Event{
+				Type:     ClientDeadEventType,
+				ClientID: client.ID,
+				Message:  fmt.Sprintf("not seen for %v", d),
+			})
+
+			s.orphanLeases(client.ID)
+		}
+	}
+}
+
+// orphanLeases holds the leases of a dead client for the grace period,
+// after which pruneLeases releases them
+func (s *Server) orphanLeases(clientID string) {
+	if s.deadClientGracePeriod == 0 {
+		return
+	}
+
+	leases, err := s.b.ListLeases(lease.LeaseFilterByClient(clientID))
+	if err != nil {
+		s.log.Println(err)
+		return
+	}
+
+	for _, l := range leases {
+		if l.Status != lease.LeaseStatusAssigned {
+			continue
+		}
+
+		s.log.Println("Orphaning lease", l.LeaseID)
+
+		err := l.Transition(lease.LeaseStatusOrphaned)
+		if err != nil {
+			s.log.Println(err)
+			continue
+		}
+
+		// the grace period only ever extends a lease
+		if grace := time.Now().Add(s.deadClientGracePeriod); grace.After(l.Expires) {
+			l.Expires = grace
+		}
+
+		err = s.b.UpdateLease(l)
+		if err != nil {
+			s.log.Println(err)
+		}
+	}
+}
+
+// restoreLeases returns the orphaned leases of a client that came back
+// within the grace period to their assigned state
+func (s *Server) restoreLeases(clientID string) error {
+	leases, err := s.b.ListLeases(lease.LeaseFilterByClient(clientID))
+	if err != nil {
+		return err
+	}
+
+	for _, l := range leases {
+		if l.Status != lease.LeaseStatusOrphaned {
+			continue
+		}
+
+		s.log.Println("Restoring lease", l.LeaseID)
+
+		err := l.Transition(lease.LeaseStatusAssigned)
+		if err != nil {
+			return err
+		}
+
+		l.Expires = time.Now().Add(l.TTL)
+		err = s.b.UpdateLease(l)
+		if err != nil {
+			return err
 		}
 	}
+
+	return nil
 }
 
 func (s *Server) pruneLeaseRequests() {
@@ -97,20 +228,57 @@ func (s *Server) pruneLeaseRequests() {
 
 	now := time.Now()
 
+	expiredGangs := make(map[string]bool)
+
 	for _, request := range requests {
 		if request.Expires.Before(now) {
+			if request.GangID != "" {
+				if expiredGangs[request.GangID] {
+					continue
+				}
+				expiredGangs[request.GangID] = true
+
+				s.expireGangLeaseRequest(request.ClientID, request.GangID)
+				continue
+			}
+
 			s.log.Println("Expiring", request.LeaseRequestID)
 
 			s.b.DeleteLeaseRequest(request.LeaseRequestID)
+			s.recordLeaseRequest(request, "", lease.HistoryStateExpired, "not renewed")
 
 			s.writeNotification(request.ClientID, NewNotification(
 				LeaseRequestExpiredNotificationType,
-				request.LeaseRequestID,
+				LeaseRequestExpiredPayload{
+					LeaseRequestID: request.LeaseRequestID,
+				},
 			))
 		}
 	}
 }
 
+func (s *Server) expireGangLeaseRequest(clientID, gangID string) {
+	s.log.Println("Expiring gang", gangID)
+
+	requests, err := s.b.ListLeaseRequests(lease.LeaseRequestFilterByGang(gangID))
+	if err != nil {
+		s.log.Println(err)
+		return
+	}
+
+	for _, request := range requests {
+		s.b.DeleteLeaseRequest(request.LeaseRequestID)
+		s.recordLeaseRequest(request, "", lease.HistoryStateExpired, "not renewed")
+	}
+
+	s.writeNotification(clientID, NewNotification(
+		LeaseRequestExpiredNotificationType,
+		LeaseRequestExpiredPayload{
+			GangID: gangID,
+		},
+	))
+}
+
 func (s *Server) pruneLeases() {
 	leases, err := s.b.ListLeases(lease.LeaseFilterAll)
 	if err != nil {
@@ -120,12 +288,38 @@ func (s *Server) pruneLeases() {
 
 	now := time.Now()
 
+	releasedGangs := make(map[string]bool)
+
 	for _, l := range leases {
+		// leases being assigned, transferred or released are cleaned up by
+		// whatever is moving them, even if that fails
+		if l.Status != lease.LeaseStatusAssigned && l.Status != lease.LeaseStatusOrphaned {
+			continue
+		}
+
 		if l.Expires.Before(now) {
+			// gang leases are released together
+			if l.GangID != "" {
+				if releasedGangs[l.GangID] {
+					continue
+				}
+				releasedGangs[l.GangID] = true
+
+				s.log.Println("Gang", l.GangID, "expired")
+				s.recordLease(l, lease.HistoryStateExpired, "not renewed")
+
+				err := s.releaseGang(l.GangID, "expired")
+				if err != nil {
+					s.log.Println(err)
+				}
+				continue
+			}
+
 			s.log.Println("Lease", l.LeaseID, "expired")
+			s.recordLease(l, lease.HistoryStateExpired, "not renewed")
 
 			// hook into release -> delete
-			err := s.releaseLease(l)
+			err := s.releaseLease(l, "expired")
 			if err != nil {
 				s.log.Println(err)
 				continue
@@ -134,13 +328,32 @@ func (s *Server) pruneLeases() {
 	}
 }
 
+// assignLease associates the volume of a newly created, Assigning lease with
+// its client
 func (s *Server) assignLease(l *lease.Lease) error {
-	l.Status = lease.LeaseStatusAssigning
-	err := s.b.UpdateLease(l)
+	if l.Status != lease.LeaseStatusAssigning {
+		return &lease.InvalidTransitionError{
+			LeaseID: l.LeaseID,
+			From:    l.Status,
+			To:      lease.LeaseStatusAssigned,
+		}
+	}
+
+	s.recordLease(l, lease.HistoryStateAssigning, "")
+
+	err := s.associateLease(l)
 	if err != nil {
+		s.failLease(l, err)
 		return err
 	}
 
+	s.recordLease(l, lease.HistoryStateAssigned, "")
+
+	return nil
+}
+
+// associateLease hands the volume of an Assigning lease to its client
+func (s *Server) associateLease(l *lease.Lease) error {
 	volume, err := s.b.GetVolume(l.VolumeID)
 	if err != nil {
 		return err
@@ -148,75 +361,325 @@ func (s *Server) assignLease(l *lease.Lease) error {
 
 	err = s.r.Associate(l)
 	if err != nil {
+		s.emitResourceError(l, err)
 		return err
 	}
 
-	volume.Status = LeasedVolumeStatus
-	err = s.b.UpdateVolume(volume)
+	// the volume's status follows its leases once its offer is resolved
+
+	// the TTL and lifetime start once the client actually holds the volume
+	s.startLifetime(l, volume)
+	err = l.Transition(lease.LeaseStatusAssigned)
+	if err != nil {
+		return err
+	}
+
+	l.Expires = time.Now().Add(l.TTL)
+	return s.b.UpdateLease(l)
+}
+
+// failLease releases a lease whose volume couldn't be assigned or transferred,
+// so the volume can be offered again. The volume may be partially associated, so it's
+// disassociated regardless
+func (s *Server) failLease(l *lease.Lease, cause error) {
+	s.log.Printf("Releasing failed lease %s: %s", l.LeaseID, cause)
+	s.recordLease(l, lease.HistoryStateFailed, cause.Error())
+
+	err := l.Transition(lease.LeaseStatusReleasing)
+	if err == nil {
+		err = s.b.UpdateLease(l)
+	}
+	if err != nil {
+		s.log.Println(err)
+	}
+
+	err = s.r.Disassociate(l)
+	if err != nil {
+		s.emitResourceError(l, err)
+		s.log.Println(err)
+	}
+
+	err = s.b.DeleteLease(l.LeaseID)
+	if err != nil {
+		s.log.Println(err)
+		return
+	}
+
+	err = s.settleVolume(l.VolumeID)
+	if err != nil {
+		s.log.Println(err)
+	}
+
+	s.recordLease(l, lease.HistoryStateReleased, "failed")
+
+	go s.iterateLeaseRequests()
+}
+
+// releaseLease returns a lease's volume to the pool, recording why it was released
+func (s *Server) releaseLease(l *lease.Lease, reason string) error {
+	s.log.Printf("Releasing lease %s: %s", l.LeaseID, reason)
+
+	err := l.Transition(lease.LeaseStatusReleasing)
 	if err != nil {
 		return err
 	}
 
-	l.Status = lease.LeaseStatusAssigned
 	err = s.b.UpdateLease(l)
 	if err != nil {
 		return err
 	}
 
+	s.recordLease(l, lease.HistoryStateReleasing, reason)
+
+	err = s.r.Disassociate(l)
+	if err != nil {
+		s.emitResourceError(l, err)
+		return err
+	}
+
+	err = s.b.DeleteLease(l.LeaseID)
+	if err != nil {
+		return err
+	}
+
+	// shared volumes stay leased while other readers remain
+	err = s.settleVolume(l.VolumeID)
+	if err != nil {
+		return err
+	}
+
+	s.recordLease(l, lease.HistoryStateReleased, reason)
+
+	go s.iterateLeaseRequests()
+
 	return nil
 }
 
-func (s *Server) releaseLease(l *lease.Lease) error {
-	l.Status = lease.LeaseStatusReleasing
-	err := s.b.UpdateLease(l)
+// sharedLeaseCount returns the number of shared leases on a volume, ignoring
+// a given lease
+func (s *Server) sharedLeaseCount(volumeID, ignoreLeaseID string) (int, error) {
+	leases, err := s.b.ListLeases(lease.LeaseFilterByVolume(volumeID))
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for _, l := range leases {
+		if l.AccessMode == lease.AccessModeShared && l.LeaseID != ignoreLeaseID {
+			n++
+		}
+	}
+
+	return n, nil
+}
+
+// checkReaders verifies a volume can take another shared lease
+func (s *Server) checkReaders(volume *Volume, request *lease.LeaseRequest) error {
+	if request.AccessMode != lease.AccessModeShared || volume.MaxReaders == 0 {
+		return nil
+	}
+
+	readers, err := s.sharedLeaseCount(volume.ID, "")
 	if err != nil {
 		return err
 	}
 
-	v, err := s.b.GetVolume(l.VolumeID)
+	if readers >= volume.MaxReaders {
+		return fmt.Errorf("volume has reached its maximum of %d readers", volume.MaxReaders)
+	}
+
+	return nil
+}
+
+func (s *Server) releaseGang(gangID string, reason string) error {
+	leases, err := s.b.ListLeases(lease.LeaseFilterByGang(gangID))
 	if err != nil {
 		return err
 	}
 
-	err = s.r.Disassociate(l)
+	for _, l := range leases {
+		err := s.releaseLease(l, reason)
+		if err != nil {
+			// the rest of the gang may still be assigning
+			var invalid *lease.InvalidTransitionError
+			if errors.As(err, &invalid) {
+				s.log.Println(err)
+				continue
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// releaseWithGang releases a lease along with the rest of its gang, notifying
+// the client of each release. Nothing is released unless every member can be,
+// and a member that fails to release doesn't stop the others
+func (s *Server) releaseWithGang(l *lease.Lease, reason string) error {
+	leases := []*lease.Lease{l}
+	if l.GangID != "" {
+		var err error
+		leases, err = s.b.ListLeases(lease.LeaseFilterByGang(l.GangID))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, member := range leases {
+		if member.Status != lease.LeaseStatusAssigned && member.Status != lease.LeaseStatusOrphaned {
+			return &lease.InvalidTransitionError{
+				LeaseID: member.LeaseID,
+				From:    member.Status,
+				To:      lease.LeaseStatusReleasing,
+			}
+		}
+	}
+
+	var failed error
+	for _, member := range leases {
+		err := s.releaseLease(member, reason)
+		if err != nil {
+			s.log.Println(err)
+			if failed == nil {
+				failed = err
+			}
+			continue
+		}
+
+		s.writeNotification(member.ClientID, NewNotification(
+			LeaseReleasedNotificationType,
+			LeaseReleasedPayload{
+				LeaseID: member.LeaseID,
+				Reason:  reason,
+			},
+		))
+	}
+
+	return failed
+}
+
+// transferLease moves a lease from its current client to another, issuing a
+// new fencing token so the previous holder can no longer use the volume
+func (s *Server) transferLease(l *lease.Lease, clientID string) error {
+	err := l.Transition(lease.LeaseStatusTransferring)
 	if err != nil {
 		return err
 	}
 
-	v.Status = AvailableVolumeStatus
-	err = s.b.UpdateVolume(v)
+	err = s.b.UpdateLease(l)
 	if err != nil {
 		return err
 	}
 
-	err = s.b.DeleteLease(l.LeaseID)
+	previousClientID := l.ClientID
+	s.recordLease(l, lease.HistoryStateReleasing, "transferring to "+clientID)
+
+	err = s.r.Disassociate(l)
 	if err != nil {
+		s.emitResourceError(l, err)
+		s.recordLease(l, lease.HistoryStateFailed, "transfer failed: "+err.Error())
+
+		// the volume is still with the original client
+		rollbackErr := l.Transition(lease.LeaseStatusAssigned)
+		if rollbackErr == nil {
+			rollbackErr = s.b.UpdateLease(l)
+		}
+
+		if rollbackErr != nil {
+			s.failLease(l, rollbackErr)
+			s.notifyTransferFailed(l, previousClientID, rollbackErr)
+			return fmt.Errorf("%v, and the lease could not be restored: %v", err, rollbackErr)
+		}
+
 		return err
 	}
 
-	go s.iterateLeaseRequests()
+	s.recordLease(l, lease.HistoryStateReleased, "transferred to "+clientID)
+
+	token, err := s.b.NextFencingToken(l.VolumeID)
+	if err == nil {
+		l.ClientID = clientID
+		l.FencingToken = token
+
+		s.recordLease(l, lease.HistoryStateAssigning, "transferred from "+previousClientID)
+
+		// the new holder gets a full lifetime of its own
+		err = s.associateLease(l)
+	}
+
+	if err != nil {
+		// the original client has already given up the volume, so the lease
+		// is released rather than handed back
+		s.failLease(l, err)
+		s.notifyTransferFailed(l, previousClientID, err)
+		return err
+	}
+
+	s.recordLease(l, lease.HistoryStateAssigned, "transferred from "+previousClientID)
 
 	return nil
 }
 
+// notifyTransferFailed tells a lease's original client it was released when
+// its transfer failed
+func (s *Server) notifyTransferFailed(l *lease.Lease, clientID string, err error) {
+	s.writeNotification(clientID, NewNotification(
+		LeaseReleasedNotificationType,
+		LeaseReleasedPayload{
+			LeaseID: l.LeaseID,
+			Reason:  "transfer failed: " + err.Error(),
+		},
+	))
+}
+
 // Prune cleans up various resources
 func (s *Server) Prune() {
 	s.pruneClients()
 	s.pruneLeaseRequests()
 	s.pruneLeases()
+	s.enforceLeaseLifetimes()
+	s.idempotency.prune(time.Now())
 }
 
-// Register adds a new client
+// Register adds a new client. A dead client registering with its previous ID
+// is revived, and any of its orphaned leases are restored
 func (s *Server) Register(ctx context.Context, req *svc.RegisterMessage) (*svc.Empty, error) {
-	err := s.b.AddClient(req.Id)
+	client, err := s.b.GetClient(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case client.ID == "":
+		err = s.b.AddClient(req.Id)
+	case client.Status == DeadClientStatus:
+		err = s.b.UpdateClient(req.Id, AliveClientStatus)
+	default:
+		// the ID belongs to a client that is still alive
+		return nil, status.Errorf(codes.AlreadyExists, "client %q is already registered", req.Id)
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	err = s.restoreLeases(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.emit(Event{
+		Type:     ClientRegisteredEventType,
+		ClientID: req.Id,
+	})
+
 	go func() {
 		s.writeNotification(req.Id, NewNotification(
 			UnknownNotificationType,
-			fmt.Sprintf("Initial notification for client %q", req.Id),
+			ClientRegisteredPayload{
+				ClientID: req.Id,
+			},
 		))
 	}()
 
@@ -238,6 +701,9 @@ func (s *Server) Deregister(ctx context.Context, req *svc.DeregisterMessage) (*s
 		if err != nil {
 			return nil, err
 		}
+
+		s.log.Println("Cancelled lease request", request.LeaseRequestID)
+		s.recordLeaseRequest(request, "", lease.HistoryStateCancelled, "client deregistered")
 	}
 
 	leases, err := s.b.ListLeases(lease.LeaseFilterByClient(clientID))
@@ -246,8 +712,16 @@ func (s *Server) Deregister(ctx context.Context, req *svc.DeregisterMessage) (*s
 	}
 
 	for _, l := range leases {
-		err := s.releaseLease(l)
+		err := s.releaseLease(l, "client deregistered")
 		if err != nil {
+			// leases still being assigned or transferred expire once they're
+			// assigned, as nobody will renew them
+			var invalid *lease.InvalidTransitionError
+			if errors.As(err, &invalid) {
+				s.log.Println(err)
+				continue
+			}
+
 			return nil, err
 		}
 	}
@@ -257,40 +731,47 @@ func (s *Server) Deregister(ctx context.Context, req *svc.DeregisterMessage) (*s
 		return nil, err
 	}
 
+	s.idempotency.forgetClient(clientID)
+
+	s.emit(Event{
+		Type:     ClientDeregisteredEventType,
+		ClientID: clientID,
+	})
+
 	return &svc.Empty{}, nil
 }
 
-// Heartbeat handles client HeartbeatMessages
+// Heartbeat handles client HeartbeatMessages. Heartbeats only track client
+// liveness, leases and lease requests are extended with RenewLease and
+// RenewLeaseRequest
 func (s *Server) Heartbeat(ctx context.Context, m *svc.HeartbeatMessage) (*svc.HeartbeatResponse, error) {
 	//s.log.Println("Seen", m.Id)
 
-	err := s.b.UpdateClient(m.Id, AliveClientStatus)
+	client, err := s.b.GetClient(m.Id)
 	if err != nil {
-		s.log.Println(err)
 		return nil, err
 	}
 
-	requests, err := s.b.ListLeaseRequests(lease.LeaseRequestFilterByClient(m.Id))
-	if err != nil {
-		return nil, err
+	// the client needs to register again, e.g. after the server restarted
+	if client.ID == "" {
+		return nil, status.Errorf(codes.NotFound, "client %q is not registered", m.Id)
 	}
 
-	for _, request := range requests {
-		request.Expires = time.Now().Add(lease.DefaultLeaseTTL)
-		err = s.b.UpdateLeaseRequest(request)
-		if err != nil {
-			return nil, err
-		}
+	// heartbeats don't make an unhealthy client healthy, catching up on its
+	// notifications does
+	clientStatus := AliveClientStatus
+	if client.Status == UnhealthyClientStatus {
+		clientStatus = UnhealthyClientStatus
 	}
 
-	leases, err := s.b.ListLeases(lease.LeaseFilterByClient(m.Id))
+	err = s.b.UpdateClient(m.Id, clientStatus)
 	if err != nil {
+		s.log.Println(err)
 		return nil, err
 	}
 
-	for _, l := range leases {
-		l.Expires = time.Now().Add(lease.DefaultLeaseTTL)
-		err = s.b.UpdateLease(l)
+	if client.Status == DeadClientStatus {
+		err = s.restoreLeases(m.Id)
 		if err != nil {
 			return nil, err
 		}
@@ -307,13 +788,35 @@ func (s *Server) Heartbeat(ctx context.Context, m *svc.HeartbeatMessage) (*svc.H
 func (s *Server) WatchNotifications(msg *svc.NotificationWatchMessage,
 	stream svc.Volchestrator_WatchNotificationsServer) error {
 
+	// notifications stay queued in the backend until they're acked, so nothing
+	// is lost when the stream goes away
 	ch := make(chan Notification)
-	go s.b.WatchNotifications(msg.Id, ch)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.b.WatchNotifications(msg.Id, msg.ResumeAfter, ch, stream.Context().Done())
+	}()
 
 	for {
 		var notification Notification
 		select {
 		case <-stream.Context().Done():
+			return nil
+		case err := <-errCh:
+			// the client needs to resync its state from scratch
+			if errors.Is(err, ErrNotificationCursorCompacted) {
+				return status.Errorf(codes.OutOfRange, "cannot resume after notification %d: %s", msg.ResumeAfter, err)
+			}
+
+			// the client resumes from its cursor once it catches up
+			if errors.Is(err, ErrNotificationStreamDropped) {
+				s.log.Println("Dropping notification stream for", msg.Id)
+				return status.Error(codes.ResourceExhausted, err.Error())
+			}
+
+			if err != nil {
+				return status.Error(codes.NotFound, err.Error())
+			}
+
 			return nil
 		case notification = <-ch:
 		}
@@ -323,14 +826,12 @@ func (s *Server) WatchNotifications(msg *svc.NotificationWatchMessage,
 			break
 		}
 
-		n := &svc.Notification{
-			Id:      notification.ID,
-			Type:    svc.NotificationType(notification.Type),
-			Message: notification.Message,
-		}
+		n := notification.toProto()
 
+		// unsent notifications are redelivered once the client watches again
 		if err := stream.Send(n); err != nil {
 			s.log.Println(err)
+			return err
 		}
 	}
 
@@ -349,6 +850,35 @@ func (s *Server) Acknowledge(ctx context.Context, msg *svc.Acknowledgement) (*sv
 	return &svc.Empty{}, nil
 }
 
+// ListNotificationStats returns the notification counters of every client, to
+// spot clients that are dropping or slow to ack notifications
+func (s *Server) ListNotificationStats(ctx context.Context, m *svc.Empty) (*svc.NotificationStatsList, error) {
+	stats, err := s.b.NotificationStats()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].ClientID < stats[j].ClientID
+	})
+
+	res := &svc.NotificationStatsList{}
+	for _, st := range stats {
+		res.Stats = append(res.Stats, &svc.NotificationStats{
+			ClientId:       st.ClientID,
+			Pending:        int64(st.Pending),
+			Written:        st.Written,
+			Delivered:      st.Delivered,
+			Redelivered:    st.Redelivered,
+			Dropped:        st.Dropped,
+			Coalesced:      st.Coalesced,
+			StreamsDropped: st.StreamsDropped,
+		})
+	}
+
+	return res, nil
+}
+
 // ListClients returns the ClientMap info
 func (s *Server) ListClients(ctx context.Context, m *svc.Empty) (*svc.ClientList, error) {
 	res := &svc.ClientList{}
@@ -390,6 +920,9 @@ func (s *Server) GetVolume(ctx context.Context, volumeID *svc.VolumeID) (*svc.Vo
 		Tags:             volume.Tags,
 		AvailabilityZone: volume.AvailabilityZone,
 		Status:           svc.VolumeStatus(volume.Status),
+		FencingToken:     volume.FencingToken,
+		MultiAttach:      volume.MultiAttach,
+		MaxReaders:       int32(volume.MaxReaders),
 	}
 
 	return v, nil
@@ -412,6 +945,9 @@ func (s *Server) ListVolumes(ctx context.Context, e *svc.Empty) (*svc.VolumeList
 			Tags:             volume.Tags,
 			AvailabilityZone: volume.AvailabilityZone,
 			Status:           svc.VolumeStatus(volume.Status),
+			FencingToken:     volume.FencingToken,
+			MultiAttach:      volume.MultiAttach,
+			MaxReaders:       int32(volume.MaxReaders),
 		})
 	}
 
@@ -425,6 +961,8 @@ func (s *Server) AddVolume(ctx context.Context, volume *svc.Volume) (*svc.Volume
 		Tags:             volume.Tags,
 		AvailabilityZone: volume.AvailabilityZone,
 		Status:           VolumeStatus(volume.Status),
+		MultiAttach:      volume.MultiAttach,
+		MaxReaders:       int(volume.MaxReaders),
 	}
 
 	err := s.b.AddVolume(v)
@@ -432,6 +970,11 @@ func (s *Server) AddVolume(ctx context.Context, volume *svc.Volume) (*svc.Volume
 		return nil, err
 	}
 
+	s.emit(Event{
+		Type:     VolumeAddedEventType,
+		VolumeID: v.ID,
+	})
+
 	go s.iterateLeaseRequests()
 
 	return volume, nil
@@ -439,57 +982,503 @@ func (s *Server) AddVolume(ctx context.Context, volume *svc.Volume) (*svc.Volume
 
 // UpdateVolume performs an in-place update of an existing volume in the backend
 func (s *Server) UpdateVolume(ctx context.Context, volume *svc.Volume) (*svc.Volume, error) {
+	existing, err := s.b.GetVolume(volume.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		return nil, status.Errorf(codes.NotFound, "volume %q not found", volume.Id)
+	}
+
+	// fencing tokens are only ever issued by the server
 	v := &Volume{
 		ID:               volume.Id,
 		Tags:             volume.Tags,
 		AvailabilityZone: volume.AvailabilityZone,
 		Status:           VolumeStatus(volume.Status),
+		FencingToken:     existing.FencingToken,
+		MultiAttach:      volume.MultiAttach,
+		MaxReaders:       int(volume.MaxReaders),
 	}
 
-	err := s.b.UpdateVolume(v)
+	err = s.b.UpdateVolume(v)
 	if err != nil {
 		return nil, err
 	}
 
+	s.emit(Event{
+		Type:     VolumeUpdatedEventType,
+		VolumeID: v.ID,
+	})
+
 	go s.iterateLeaseRequests()
 
+	volume.FencingToken = v.FencingToken
+
 	return volume, nil
 }
 
 // DeleteVolume deletes a volume from the backend
 func (s *Server) DeleteVolume(ctx context.Context, volumeID *svc.VolumeID) (*svc.Empty, error) {
 	err := s.b.DeleteVolume(volumeID.Id)
+	if err != nil {
+		return nil, err
+	}
 
 	// TODO verify status
 
-	return &svc.Empty{}, err
+	s.emit(Event{
+		Type:     VolumeDeletedEventType,
+		VolumeID: volumeID.Id,
+	})
+
+	return &svc.Empty{}, nil
 }
 
-// SubmitLeaseRequest adds a LeaseRequest to the backend
-func (s *Server) SubmitLeaseRequest(ctx context.Context, request *svc.LeaseRequest) (*svc.Empty, error) {
-	requestID := randstr.Hex(16)
-	err := s.b.AddLeaseRequest(&lease.LeaseRequest{
+// SubmitLeaseRequest adds a LeaseRequest to the backend, returning its ID.
+// Retries carrying the same idempotency key as an earlier submission return
+// the ID of the lease request that submission created
+func (s *Server) SubmitLeaseRequest(ctx context.Context, request *svc.LeaseRequest) (*svc.LeaseRequestID, error) {
+	var requestID string
+	var err error
+
+	if request.IdempotencyKey != "" {
+		requestID, err = s.submitIdempotent(ctx, request.ClientId, request.IdempotencyKey, newIdempotentRequest(request), func(id string) error {
+			return s.submitLeaseRequest(request, id)
+		})
+	} else {
+		requestID = randstr.Hex(16)
+		err = s.submitLeaseRequest(request, requestID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &svc.LeaseRequestID{
+		Id: requestID,
+	}, nil
+}
+
+// submitLeaseRequest adds a LeaseRequest with a given ID to the backend
+func (s *Server) submitLeaseRequest(request *svc.LeaseRequest, requestID string) error {
+	// requests for a specific volume are only valid if that volume exists;
+	// if it is currently leased the request is simply queued
+	if request.VolumeId != "" {
+		volume, err := s.b.GetVolume(request.VolumeId)
+		if err != nil {
+			return err
+		}
+
+		if volume == nil {
+			return status.Errorf(codes.NotFound, "volume %q not found", request.VolumeId)
+		}
+	}
+
+	ttl, err := s.leaseTTL(request.Ttl)
+	if err != nil {
+		return err
+	}
+
+	leaseRequest := &lease.LeaseRequest{
 		LeaseRequestID:         requestID,
 		ClientID:               request.ClientId,
 		VolumeTag:              request.Tag,
 		VolumeAvailabilityZone: request.AvailabilityZone,
-		Expires:                time.Now().Add(lease.DefaultLeaseTTL),
-	})
+		VolumeID:               request.VolumeId,
+		Expires:                time.Now().Add(ttl),
+		TTL:                    ttl,
+		Priority:               int(request.Priority),
+		AccessMode:             lease.AccessMode(request.AccessMode),
+	}
 
+	err = s.checkLeaseRequestQuota(request.ClientId, []*lease.LeaseRequest{leaseRequest})
+	if err != nil {
+		return err
+	}
+
+	err = s.b.AddLeaseRequest(leaseRequest)
+	if err != nil {
+		return err
+	}
+
+	s.recordLeaseRequest(leaseRequest, "", lease.HistoryStateRequested, "")
+
+	s.writeNotification(request.ClientId, NewNotification(
+		LeaseRequestAckNotificationType,
+		LeaseRequestAckPayload{
+			LeaseRequestID: requestID,
+		},
+	))
+
+	go s.iterateLeaseRequests()
+
+	return nil
+}
+
+// SubmitGangLeaseRequest adds a group of LeaseRequests to the backend that
+// will be satisfied all-or-nothing, returning the gang ID. Retries carrying
+// the same idempotency key as an earlier submission return the ID of the gang
+// that submission created
+func (s *Server) SubmitGangLeaseRequest(ctx context.Context, request *svc.GangLeaseRequest) (*svc.LeaseRequestID, error) {
+	var gangID string
+	var err error
+
+	if request.IdempotencyKey != "" {
+		gangID, err = s.submitIdempotent(ctx, request.ClientId, request.IdempotencyKey, newIdempotentGangRequest(request), func(id string) error {
+			return s.submitGangLeaseRequest(request, id)
+		})
+	} else {
+		gangID = randstr.Hex(16)
+		err = s.submitGangLeaseRequest(request, gangID)
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	return &svc.LeaseRequestID{
+		Id: gangID,
+	}, nil
+}
+
+// submitGangLeaseRequest adds a group of LeaseRequests with a given gang ID to
+// the backend
+func (s *Server) submitGangLeaseRequest(request *svc.GangLeaseRequest, gangID string) error {
+	if len(request.Requests) == 0 {
+		return status.Error(codes.InvalidArgument, "gang lease request has no lease requests")
+	}
+
+	for _, r := range request.Requests {
+		// retries are deduped by the gang's idempotency key
+		if r.IdempotencyKey != "" {
+			return status.Error(codes.InvalidArgument, "idempotency keys are set on gang lease requests, not their members")
+		}
+
+		if r.VolumeId == "" {
+			continue
+		}
+
+		volume, err := s.b.GetVolume(r.VolumeId)
+		if err != nil {
+			return err
+		}
+
+		if volume == nil {
+			return status.Errorf(codes.NotFound, "volume %q not found", r.VolumeId)
+		}
+	}
+
+	ttl, err := s.leaseTTL(request.Ttl)
+	if err != nil {
+		return err
+	}
+
+	expires := time.Now().Add(ttl)
+
+	leaseRequests := []*lease.LeaseRequest{}
+	for _, r := range request.Requests {
+		leaseRequests = append(leaseRequests, &lease.LeaseRequest{
+			LeaseRequestID:         randstr.Hex(16),
+			ClientID:               request.ClientId,
+			VolumeTag:              r.Tag,
+			VolumeAvailabilityZone: r.AvailabilityZone,
+			VolumeID:               r.VolumeId,
+			Expires:                expires,
+			TTL:                    ttl,
+			Priority:               int(r.Priority),
+			AccessMode:             lease.AccessMode(r.AccessMode),
+			GangID:                 gangID,
+		})
+	}
+
+	err = s.checkLeaseRequestQuota(request.ClientId, leaseRequests)
+	if err != nil {
+		return err
+	}
+
+	for _, leaseRequest := range leaseRequests {
+		err := s.b.AddLeaseRequest(leaseRequest)
+		if err != nil {
+			// don't leave a partial gang behind
+			requests, _ := s.b.ListLeaseRequests(lease.LeaseRequestFilterByGang(gangID))
+			for _, request := range requests {
+				s.b.DeleteLeaseRequest(request.LeaseRequestID)
+			}
+
+			return err
+		}
+	}
+
+	for _, leaseRequest := range leaseRequests {
+		s.recordLeaseRequest(leaseRequest, "", lease.HistoryStateRequested, "gang "+gangID)
+	}
+
 	s.writeNotification(request.ClientId, NewNotification(
 		LeaseRequestAckNotificationType,
-		fmt.Sprintf("Received LeaseRequest submission for %+v, ID: %s", request, requestID),
+		LeaseRequestAckPayload{
+			GangID: gangID,
+		},
 	))
 
 	go s.iterateLeaseRequests()
 
+	return nil
+}
+
+// ReleaseLease releases a Lease held by the calling client. Gang leases are
+// released together with the rest of their gang
+func (s *Server) ReleaseLease(ctx context.Context, msg *svc.ReleaseLeaseMessage) (*svc.Empty, error) {
+	l, err := s.b.GetLease(msg.LeaseId)
+	if err != nil {
+		return nil, err
+	}
+
+	if l == nil {
+		return nil, status.Errorf(codes.NotFound, "lease %q not found", msg.LeaseId)
+	}
+
+	if l.ClientID != msg.ClientId {
+		return nil, status.Errorf(codes.PermissionDenied, "lease %q is not held by client %q", msg.LeaseId, msg.ClientId)
+	}
+
+	if l.Status != lease.LeaseStatusAssigned {
+		return nil, status.Errorf(codes.FailedPrecondition, "lease %q is not assigned", msg.LeaseId)
+	}
+
+	err = s.releaseWithGang(l, "released by client")
+	if err != nil {
+		var invalid *lease.InvalidTransitionError
+		if errors.As(err, &invalid) {
+			return nil, status.Errorf(codes.FailedPrecondition, "lease %q is not assigned", invalid.LeaseID)
+		}
+
+		return nil, err
+	}
+
+	return &svc.Empty{}, nil
+}
+
+// TransferLease hands a Lease held by the calling client directly to another
+// registered and alive client, without returning the volume to the pool
+func (s *Server) TransferLease(ctx context.Context, msg *svc.TransferLeaseMessage) (*svc.Empty, error) {
+	l, err := s.b.GetLease(msg.LeaseId)
+	if err != nil {
+		return nil, err
+	}
+
+	if l == nil {
+		return nil, status.Errorf(codes.NotFound, "lease %q not found", msg.LeaseId)
+	}
+
+	if l.ClientID != msg.ClientId {
+		return nil, status.Errorf(codes.PermissionDenied, "lease %q is not held by client %q", msg.LeaseId, msg.ClientId)
+	}
+
+	if msg.TargetClientId == msg.ClientId {
+		return nil, status.Errorf(codes.InvalidArgument, "lease %q is already held by client %q", msg.LeaseId, msg.ClientId)
+	}
+
+	if l.Status != lease.LeaseStatusAssigned {
+		return nil, status.Errorf(codes.FailedPrecondition, "lease %q is not assigned", msg.LeaseId)
+	}
+
+	// gang leases only make sense together
+	if l.GangID != "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "lease %q belongs to gang %q", msg.LeaseId, l.GangID)
+	}
+
+	target, err := s.b.GetClient(msg.TargetClientId)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.ID == "" {
+		return nil, status.Errorf(codes.NotFound, "client %q is not registered", msg.TargetClientId)
+	}
+
+	if target.Status != AliveClientStatus {
+		return nil, status.Errorf(codes.FailedPrecondition, "client %q is not alive", msg.TargetClientId)
+	}
+
+	volume, err := s.b.GetVolume(l.VolumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if volume != nil {
+		err = s.checkLeaseQuota(msg.TargetClientId, volume)
+		if err != nil {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+	}
+
+	s.log.Println("Transferring lease", l.LeaseID, "from", msg.ClientId, "to", msg.TargetClientId)
+
+	err = s.transferLease(l, msg.TargetClientId)
+	if err != nil {
+		return nil, err
+	}
+
+	s.writeNotification(msg.ClientId, NewNotification(
+		LeaseTransferredNotificationType,
+		LeaseTransferredPayload{
+			LeaseID:        l.LeaseID,
+			TargetClientID: msg.TargetClientId,
+		},
+	))
+
+	s.writeNotification(msg.TargetClientId, NewNotification(
+		LeaseNotificationType,
+		LeaseGrantedPayload{
+			Lease: *l,
+		},
+	))
+
 	return &svc.Empty{}, nil
 }
 
+// RenewLease extends a Lease held by the calling client by its TTL. Gang
+// leases are renewed together with the rest of their gang
+func (s *Server) RenewLease(ctx context.Context, msg *svc.RenewLeaseMessage) (*svc.RenewLeaseResponse, error) {
+	l, err := s.b.GetLease(msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if l == nil {
+		return nil, status.Errorf(codes.NotFound, "lease %q not found", msg.Id)
+	}
+
+	if l.ClientID != msg.ClientId {
+		return nil, status.Errorf(codes.PermissionDenied, "lease %q is not held by client %q", msg.Id, msg.ClientId)
+	}
+
+	if l.Status == lease.LeaseStatusReleasing {
+		return nil, status.Errorf(codes.FailedPrecondition, "lease %q is being released", msg.Id)
+	}
+
+	leases := []*lease.Lease{l}
+	if l.GangID != "" {
+		leases, err = s.b.ListLeases(lease.LeaseFilterByGang(l.GangID))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	for _, l := range leases {
+		// a renewal proves the client is still around
+		if l.Status == lease.LeaseStatusOrphaned {
+			err := l.Transition(lease.LeaseStatusAssigned)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		l.Expires = now.Add(l.TTL)
+		err := s.b.UpdateLease(l)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return renewLeaseResponse(msg.Id, l.Expires), nil
+}
+
+// RenewLeaseRequest extends a pending LeaseRequest, or all members of a gang
+// lease request, by its TTL
+func (s *Server) RenewLeaseRequest(ctx context.Context, msg *svc.RenewLeaseMessage) (*svc.RenewLeaseResponse, error) {
+	request, err := s.b.GetLeaseRequest(msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := []*lease.LeaseRequest{}
+	if request != nil {
+		requests = append(requests, request)
+	} else {
+		requests, err = s.b.ListLeaseRequests(lease.LeaseRequestFilterByGang(msg.Id))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(requests) == 0 {
+		return nil, status.Errorf(codes.NotFound, "lease request %q not found", msg.Id)
+	}
+
+	if requests[0].ClientID != msg.ClientId {
+		return nil, status.Errorf(codes.PermissionDenied, "lease request %q is not owned by client %q", msg.Id, msg.ClientId)
+	}
+
+	now := time.Now()
+	for _, request := range requests {
+		request.Expires = now.Add(request.TTL)
+		err := s.b.UpdateLeaseRequest(request)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return renewLeaseResponse(msg.Id, requests[0].Expires), nil
+}
+
+// ValidateLease reports whether a fencing token belongs to the current holder
+// of a volume, so storage agents can reject operations from stale holders
+func (s *Server) ValidateLease(ctx context.Context, msg *svc.ValidateLeaseMessage) (*svc.ValidateLeaseResponse, error) {
+	l, err := s.b.GetLease(msg.LeaseId)
+	if err != nil {
+		return nil, err
+	}
+
+	if l == nil {
+		return invalidLease("lease %q does not exist", msg.LeaseId), nil
+	}
+
+	if l.FencingToken != msg.FencingToken {
+		return invalidLease("fencing token %d does not match lease %q", msg.FencingToken, msg.LeaseId), nil
+	}
+
+	if l.Status != lease.LeaseStatusAssigned {
+		return invalidLease("lease %q is not assigned", msg.LeaseId), nil
+	}
+
+	volume, err := s.b.GetVolume(l.VolumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if volume == nil {
+		return invalidLease("volume %q does not exist", l.VolumeID), nil
+	}
+
+	// shared leases are read-only, so concurrent readers don't fence each other
+	if l.AccessMode == lease.AccessModeExclusive && volume.FencingToken != msg.FencingToken {
+		return invalidLease("fencing token %d has been superseded by %d", msg.FencingToken, volume.FencingToken), nil
+	}
+
+	return &svc.ValidateLeaseResponse{
+		Valid: true,
+	}, nil
+}
+
+func invalidLease(format string, a ...interface{}) *svc.ValidateLeaseResponse {
+	return &svc.ValidateLeaseResponse{
+		Valid:  false,
+		Reason: fmt.Sprintf(format, a...),
+	}
+}
+
+func renewLeaseResponse(id string, expires time.Time) *svc.RenewLeaseResponse {
+	e, _ := ptypes.TimestampProto(expires)
+
+	return &svc.RenewLeaseResponse{
+		Id:      id,
+		Ttl:     ptypes.DurationProto(time.Until(expires)),
+		Expires: e,
+	}
+}
+
 // ListLeases returns all Leases in the backend
 func (s *Server) ListLeases(ctx context.Context, e *svc.Empty) (*svc.LeaseList, error) {
 	leases, err := s.b.ListLeases(lease.LeaseFilterAll)
@@ -501,14 +1490,7 @@ func (s *Server) ListLeases(ctx context.Context, e *svc.Empty) (*svc.LeaseList,
 	l := []*svc.Lease{}
 
 	for _, lease := range leases {
-		e, _ := ptypes.TimestampProto(lease.Expires)
-		l = append(l, &svc.Lease{
-			LeaseId:  lease.LeaseID,
-			ClientId: lease.ClientID,
-			VolumeId: lease.VolumeID,
-			Expires:  e,
-			Status:   svc.LeaseStatus(lease.Status),
-		})
+		l = append(l, leaseProto(lease))
 	}
 
 	res.Leases = l
@@ -516,6 +1498,54 @@ func (s *Server) ListLeases(ctx context.Context, e *svc.Empty) (*svc.LeaseList,
 	return res, nil
 }
 
+// ListClientLeases returns the Leases held by a given client, including
+// leases still being assigned
+func (s *Server) ListClientLeases(ctx context.Context, msg *svc.ListClientLeasesMessage) (*svc.LeaseList, error) {
+	leases, err := s.b.ListLeases(lease.LeaseFilterByClient(msg.ClientId))
+	if err != nil {
+		return nil, err
+	}
+
+	l := []*svc.Lease{}
+	for _, lease := range leases {
+		l = append(l, leaseProto(lease))
+	}
+
+	return &svc.LeaseList{
+		Leases: l,
+	}, nil
+}
+
+// leaseProto converts a Lease to its gRPC representation
+func leaseProto(l *lease.Lease) *svc.Lease {
+	e, _ := ptypes.TimestampProto(l.Expires)
+	a, _ := ptypes.TimestampProto(l.Acquired)
+
+	// leases without a maximum lifetime have no deadline
+	var d *timestamppb.Timestamp
+	if !l.Deadline.IsZero() {
+		d, _ = ptypes.TimestampProto(l.Deadline)
+	}
+
+	return &svc.Lease{
+		LeaseId:  l.LeaseID,
+		ClientId: l.ClientID,
+		VolumeId: l.VolumeID,
+		Expires:  e,
+		Status:   svc.LeaseStatus(l.Status),
+		GangId:   l.GangID,
+		Priority: int32(l.Priority),
+		Ttl:      ptypes.DurationProto(time.Until(l.Expires)),
+
+		FencingToken: l.FencingToken,
+		AccessMode:   svc.LeaseAccessMode(l.AccessMode),
+		Acquired:     a,
+		Deadline:     d,
+
+		LeaseRequestId: l.LeaseRequestID,
+	}
+}
+
 func (s *Server) writeNotification(id string, n Notification) Notification {
 	err := s.b.WriteNotification(id, n)
 	if err != nil {
@@ -536,14 +1566,47 @@ func contains(needle string, haystack []string) bool {
 	return false
 }
 
+// leaseRequestMismatches returns the reasons a volume does not satisfy the
+// selectors of a lease request, or nothing if it does
+func leaseRequestMismatches(volume *Volume, request *lease.LeaseRequest) []string {
+	var reasons []string
+
+	if request.VolumeID != "" {
+		// requests for a specific volume ignore the tag and az selectors
+		if request.VolumeID != volume.ID {
+			reasons = append(reasons, fmt.Sprintf("lease request is for volume %q", request.VolumeID))
+		}
+	} else {
+		if request.VolumeAvailabilityZone != volume.AvailabilityZone {
+			reasons = append(reasons, fmt.Sprintf("volume is in availability zone %q, lease request wants %q",
+				volume.AvailabilityZone, request.VolumeAvailabilityZone))
+		}
+
+		if !contains(request.VolumeTag, volume.Tags) {
+			reasons = append(reasons, fmt.Sprintf("volume does not have tag %q", request.VolumeTag))
+		}
+	}
+
+	if request.AccessMode == lease.AccessModeShared && !volume.MultiAttach {
+		reasons = append(reasons, "volume does not support multi-attach")
+	}
+
+	if request.AccessMode == lease.AccessModeExclusive && volume.Status == SharedLeasedVolumeStatus {
+		reasons = append(reasons, "volume is leased read-only by other clients")
+	}
+
+	return reasons
+}
+
+func matchLeaseRequest(volume *Volume, request *lease.LeaseRequest) bool {
+	return len(leaseRequestMismatches(volume, request)) == 0
+}
+
 func filterLeaseRequests(volume *Volume, requests []*lease.LeaseRequest) []*lease.LeaseRequest {
 	matchedRequests := []*lease.LeaseRequest{}
 
 	for _, request := range requests {
-		match := request.VolumeAvailabilityZone == volume.AvailabilityZone &&
-			contains(request.VolumeTag, volume.Tags)
-
-		if match {
+		if matchLeaseRequest(volume, request) {
 			matchedRequests = append(matchedRequests, request)
 		}
 	}
@@ -551,6 +1614,44 @@ func filterLeaseRequests(volume *Volume, requests []*lease.LeaseRequest) []*leas
 	return matchedRequests
 }
 
+// reserveGangVolumes finds a distinct volume for every request in a gang,
+// returning the volumes in request order, or nil if the gang cannot be
+// satisfied in full
+func reserveGangVolumes(volumes []*Volume, requests []*lease.LeaseRequest) []*Volume {
+	reserved := make([]*Volume, len(requests))
+	used := make(map[string]bool)
+
+	var assign func(i int) bool
+	assign = func(i int) bool {
+		if i == len(requests) {
+			return true
+		}
+
+		for _, volume := range volumes {
+			if used[volume.ID] || !matchLeaseRequest(volume, requests[i]) {
+				continue
+			}
+
+			used[volume.ID] = true
+			reserved[i] = volume
+
+			if assign(i + 1) {
+				return true
+			}
+
+			delete(used, volume.ID)
+		}
+
+		return false
+	}
+
+	if !assign(0) {
+		return nil
+	}
+
+	return reserved
+}
+
 func (s *Server) iterateLeaseRequests() {
 	s.iterateWatch <- struct{}{}
 }
@@ -573,13 +1674,142 @@ func (l *lrm) mark(id string) bool {
 	return true
 }
 
+func (l *lrm) unmark(id string) {
+	l.l.Lock()
+	defer l.l.Unlock()
+
+	delete(l.m, id)
+}
+
+// offerMap tracks the lease request each volume is currently offered to
+type offerMap struct {
+	m map[string]*offer
+	l sync.Mutex
+}
+
+// offer is a volume offered to a lease request. Once the client acks it the
+// offer is claimed and the lease is created, unless the lease request was
+// withdrawn first
+type offer struct {
+	request *lease.LeaseRequest
+	claimed bool
+
+	// withdrawn is closed when the lease request is cancelled or updated
+	withdrawn chan struct{}
+}
+
+// errOfferClaimed is returned when withdrawing a lease request whose offer
+// was already accepted
+var errOfferClaimed = errors.New("lease request has already accepted an offer")
+
+// errOfferPending is returned when changing a lease request with an offer
+// awaiting its client's ack
+var errOfferPending = errors.New("lease request has a pending offer")
+
+// set records an offer, returning a channel closed if it is withdrawn
+func (o *offerMap) set(volumeID string, request *lease.LeaseRequest) <-chan struct{} {
+	o.l.Lock()
+	defer o.l.Unlock()
+
+	off := &offer{
+		request:   request,
+		withdrawn: make(chan struct{}),
+	}
+	o.m[volumeID] = off
+
+	return off.withdrawn
+}
+
+func (o *offerMap) get(volumeID string) *lease.LeaseRequest {
+	o.l.Lock()
+	defer o.l.Unlock()
+
+	if off, ok := o.m[volumeID]; ok {
+		return off.request
+	}
+
+	return nil
+}
+
+// claim marks the offers of some volumes as accepted, returning false if any
+// of them was withdrawn in the meantime
+func (o *offerMap) claim(volumeIDs ...string) bool {
+	o.l.Lock()
+	defer o.l.Unlock()
+
+	for _, id := range volumeIDs {
+		off, ok := o.m[id]
+		if !ok {
+			return false
+		}
+
+		select {
+		case <-off.withdrawn:
+			return false
+		default:
+		}
+	}
+
+	for _, id := range volumeIDs {
+		o.m[id].claimed = true
+	}
+
+	return true
+}
+
+// withdraw calls a given function to cancel or change lease requests, after
+// withdrawing their pending offers. Requests with a claimed offer are left
+// alone, as their leases are already being created. It is done while holding
+// the offers, so no offer for those requests can be claimed concurrently
+func (o *offerMap) withdraw(leaseRequestIDs []string, allowPending bool, f func() error) error {
+	o.l.Lock()
+	defer o.l.Unlock()
+
+	offers := []*offer{}
+	for _, off := range o.m {
+		for _, id := range leaseRequestIDs {
+			if off.request.LeaseRequestID != id {
+				continue
+			}
+
+			if off.claimed {
+				return errOfferClaimed
+			}
+
+			if !allowPending {
+				return errOfferPending
+			}
+
+			offers = append(offers, off)
+		}
+	}
+
+	err := f()
+	if err != nil {
+		return err
+	}
+
+	for _, off := range offers {
+		close(off.withdrawn)
+	}
+
+	return nil
+}
+
+func (o *offerMap) clear(volumeID string) {
+	o.l.Lock()
+	defer o.l.Unlock()
+
+	delete(o.m, volumeID)
+}
+
 func (s *Server) watchLeaseRequestIterations() {
 	for {
 		select {
 		case <-s.iterateWatch:
 			s.log.Println("do iterateLeaseRequests")
 
-			volumes, err := s.b.ListVolumes(VolumeFilterByStatus(AvailableVolumeStatus))
+			volumes, err := s.b.ListVolumes(VolumeFilterLeasable)
 			if err != nil {
 				s.log.Println(err)
 				return
@@ -595,6 +1825,86 @@ func (s *Server) watchLeaseRequestIterations() {
 				m: make(map[string]bool),
 			}
 
+			// gang requests are satisfied all-or-nothing, so their volumes are
+			// reserved here before individual requests get a chance to claim them
+			singleRequests := []*lease.LeaseRequest{}
+			gangs := make(map[string][]*lease.LeaseRequest)
+			for _, request := range requests {
+				if request.GangID == "" {
+					singleRequests = append(singleRequests, request)
+					continue
+				}
+
+				gangs[request.GangID] = append(gangs[request.GangID], request)
+			}
+			requests = singleRequests
+
+			// offer volumes to higher priority requests first
+			sort.SliceStable(requests, func(i, j int) bool {
+				return requests[i].Priority > requests[j].Priority
+			})
+
+			gangVolumes := []*Volume{}
+			for _, volume := range volumes {
+				if gangVolumeEligible(volume) {
+					gangVolumes = append(gangVolumes, volume)
+				}
+			}
+
+			for gangID, members := range gangs {
+				reserved := reserveGangVolumes(gangVolumes, members)
+				if reserved == nil {
+					continue
+				}
+
+				err := s.checkLeaseQuota(members[0].ClientID, reserved...)
+				if err != nil {
+					s.log.Println("Skipping gang", gangID, err)
+					continue
+				}
+
+				reservedIDs := make(map[string]bool)
+				for _, volume := range reserved {
+					previous, err := s.reserveVolume(volume.ID, true)
+					if err != nil {
+						s.log.Println(err)
+					}
+
+					if previous == nil {
+						break
+					}
+
+					reservedIDs[volume.ID] = true
+				}
+
+				// another offer got to one of the volumes first
+				if len(reservedIDs) != len(reserved) {
+					s.log.Println("Skipping gang", gangID, "as its volumes are no longer available")
+					for id := range reservedIDs {
+						s.unreserveVolume(id)
+					}
+					continue
+				}
+
+				remaining := []*Volume{}
+				for _, volume := range volumes {
+					if !reservedIDs[volume.ID] {
+						remaining = append(remaining, volume)
+					}
+				}
+				volumes = remaining
+
+				remaining = []*Volume{}
+				for _, volume := range gangVolumes {
+					if !reservedIDs[volume.ID] {
+						remaining = append(remaining, volume)
+					}
+				}
+				gangVolumes = remaining
+
+				go s.tryGangLease(gangID, members, reserved)
+			}
+
 			for _, volume := range volumes {
 				s.log.Println("Try to lease", volume.ID)
 
@@ -604,93 +1914,379 @@ func (s *Server) watchLeaseRequestIterations() {
 
 				go s.tryLease(volume, filteredRequests, reqMap)
 			}
+
+			if s.preemption != nil {
+				s.preemptLeases(volumes, requests)
+			}
 		}
 	}
 }
 
+// gangVolumeEligible reports whether a volume may be offered to a gang. Gangs
+// are only offered volumes without any existing leases
+func gangVolumeEligible(volume *Volume) bool {
+	return volume.Status == AvailableVolumeStatus
+}
+
 // given a list of LeaseRequest, try to find a lease
 func (s *Server) tryLease(volume *Volume, requests []*lease.LeaseRequest, reqMap *lrm) {
-	// set the volume status to pending
-	volume.Status = LeasePendingVolumeStatus
-	err := s.b.UpdateVolume(volume)
+	// reserve the volume while it's offered. It may have been leased or
+	// offered elsewhere since it was listed, so requests are matched against
+	// its status from just before the reservation
+	previous, err := s.reserveVolume(volume.ID, false)
 	if err != nil {
 		s.log.Println(err)
+		return
 	}
 
+	if previous == nil {
+		s.log.Println("Volume", volume.ID, "is no longer available")
+		return
+	}
+
+	volume = previous
+	requests = filterLeaseRequests(volume, requests)
+
 	for _, request := range requests {
+		// skip requests that would take the client over its lease quota
+		err := s.checkLeaseQuota(request.ClientID, volume)
+		if err != nil {
+			s.log.Println("Skipping", request.LeaseRequestID, err)
+			continue
+		}
+
+		err = s.checkReaders(volume, request)
+		if err != nil {
+			s.log.Println("Skipping", request.LeaseRequestID, err)
+			continue
+		}
+
 		if !reqMap.mark(request.LeaseRequestID) {
 			s.log.Println("already seen", request.LeaseRequestID)
 			continue
 		}
 
+		// the request may have been cancelled or updated since it was listed
+		if !s.leaseRequestCurrent(request) {
+			s.log.Println("Skipping", request.LeaseRequestID, "as it changed")
+			continue
+		}
+
 		// notify the client the lease is available
+		withdrawn := s.offers.set(volume.ID, request)
 		n := s.writeNotification(request.ClientID, NewNotification(
 			LeaseAvailableNotificationType,
-			request.LeaseRequestID,
+			LeaseAvailablePayload{
+				LeaseRequestID: request.LeaseRequestID,
+				VolumeIDs:      []string{volume.ID},
+			},
 		))
 
 		if n.ID == "" {
 			// failure to write the notification, move on
+			s.offers.clear(volume.ID)
 			continue
 		}
 
+		s.recordLeaseRequest(request, volume.ID, lease.HistoryStateOffered, "")
+
 		t := time.After(lease.LeaseAvailableAckTTL)
 		ackCh, err := s.b.WatchNotification(n.ID)
 		if err != nil {
 			s.log.Println(err)
+			s.offers.clear(volume.ID)
+			s.recordLeaseRequest(request, volume.ID, lease.HistoryStateFailed, err.Error())
 			continue
 		}
 
 		select {
 		case <-t:
 			s.log.Println("TIMEOUT")
+			s.offers.clear(volume.ID)
+			s.recordLeaseRequest(request, volume.ID, lease.HistoryStateFailed, "offer was not acknowledged")
+			continue
+		case <-withdrawn:
+			s.offers.clear(volume.ID)
+			s.recordLeaseRequest(request, volume.ID, lease.HistoryStateFailed, "offer was withdrawn")
 			continue
 		case <-ackCh:
+			// the request may have been cancelled before it was offered
+			if !s.offers.claim(volume.ID) || !s.leaseRequestCurrent(request) {
+				s.offers.clear(volume.ID)
+				s.recordLeaseRequest(request, volume.ID, lease.HistoryStateFailed, "offer was withdrawn")
+				continue
+			}
+
 			s.log.Println("we haz lease")
+			s.recordLeaseRequest(request, volume.ID, lease.HistoryStateAcked, "")
+
+			token, err := s.b.NextFencingToken(volume.ID)
+			if err != nil {
+				s.log.Println(err)
+				s.offers.clear(volume.ID)
+				continue
+			}
 
 			l := &lease.Lease{
-				LeaseID:  randstr.Hex(16),
-				ClientID: request.ClientID,
-				VolumeID: volume.ID,
-				Expires:  time.Now().Add(lease.DefaultLeaseTTL),
-				Status:   lease.LeaseStatusAssigning,
+				LeaseID:        randstr.Hex(16),
+				LeaseRequestID: request.LeaseRequestID,
+				ClientID:       request.ClientID,
+				VolumeID:       volume.ID,
+				Expires:        time.Now().Add(request.TTL),
+				TTL:            request.TTL,
+				Priority:       request.Priority,
+
+				AccessMode:   request.AccessMode,
+				FencingToken: token,
+			}
+
+			err = l.Transition(lease.LeaseStatusAssigning)
+			if err != nil {
+				s.log.Println(err)
+				s.offers.clear(volume.ID)
+				continue
+			}
+
+			// other offers to the client may have been claimed since this one
+			// was made
+			unlock, err := s.lockLeaseQuota(request.ClientID, volume)
+			if err != nil {
+				s.log.Println("Skipping", request.LeaseRequestID, err)
+				s.offers.clear(volume.ID)
+				s.recordLeaseRequest(request, volume.ID, lease.HistoryStateFailed, err.Error())
+				continue
 			}
 
 			err = s.b.AddLease(l)
+			unlock()
 			if err != nil {
 				s.log.Println(err)
+				s.offers.clear(volume.ID)
 				continue
 				// TODO we're in a bad state here
 			}
 
 			err = s.b.DeleteLeaseRequest(request.LeaseRequestID)
+			s.offers.clear(volume.ID)
 			if err != nil {
 				s.log.Println(err)
 				continue
 			}
 
+			// a failed assignment is released along with its lease
 			err = s.assignLease(l)
 			if err != nil {
-				s.log.Println(err)
 				continue
 			}
 
-			m, _ := json.Marshal(l)
-
 			s.writeNotification(request.ClientID, NewNotification(
 				LeaseNotificationType,
-				string(m), // format a message with the volume and lease id
+				LeaseGrantedPayload{
+					Lease: *l,
+				},
 			))
 
+			s.unreserveVolume(volume.ID)
+
+			// give other readers a chance at the volume
+			if l.AccessMode == lease.AccessModeShared {
+				go s.iterateLeaseRequests()
+			}
+
 			return // lol
 		}
 	}
 
-	// set the volume status to available as we never found a lease
+	// the volume's status follows whatever leases it has left
 	s.log.Println("Did not lease", volume.ID)
-	volume.Status = AvailableVolumeStatus
-	err = s.b.UpdateVolume(volume)
+	s.unreserveVolume(volume.ID)
+}
+
+// tryGangLease offers a set of reserved volumes to the owner of a gang, and
+// creates all the gang's leases once the offer is acked
+func (s *Server) tryGangLease(gangID string, requests []*lease.LeaseRequest, volumes []*Volume) {
+	clientID := requests[0].ClientID
+
+	// the gang is withdrawn as a whole, so any member's channel will do
+	var withdrawn <-chan struct{}
+	for i, volume := range volumes {
+		defer s.unreserveVolume(volume.ID)
+
+		withdrawn = s.offers.set(volume.ID, requests[i])
+		defer s.offers.clear(volume.ID)
+	}
+
+	volumeIDs := []string{}
+	for _, volume := range volumes {
+		volumeIDs = append(volumeIDs, volume.ID)
+	}
+
+	n := s.writeNotification(clientID, NewNotification(
+		LeaseAvailableNotificationType,
+		LeaseAvailablePayload{
+			GangID:    gangID,
+			VolumeIDs: volumeIDs,
+		},
+	))
+
+	if n.ID != "" {
+		for i, request := range requests {
+			s.recordLeaseRequest(request, volumes[i].ID, lease.HistoryStateOffered, "gang "+gangID)
+		}
+
+		t := time.After(lease.LeaseAvailableAckTTL)
+		ackCh, err := s.b.WatchNotification(n.ID)
+		if err != nil {
+			s.log.Println(err)
+		} else {
+			select {
+			case <-t:
+				s.log.Println("TIMEOUT")
+				for i, request := range requests {
+					s.recordLeaseRequest(request, volumes[i].ID, lease.HistoryStateFailed, "offer was not acknowledged")
+				}
+			case <-withdrawn:
+				for i, request := range requests {
+					s.recordLeaseRequest(request, volumes[i].ID, lease.HistoryStateFailed, "offer was withdrawn")
+				}
+			case <-ackCh:
+				if !s.offers.claim(volumeIDs...) || !s.leaseRequestCurrent(requests[0]) {
+					for i, request := range requests {
+						s.recordLeaseRequest(request, volumes[i].ID, lease.HistoryStateFailed, "offer was withdrawn")
+					}
+					break
+				}
+
+				s.log.Println("we haz gang lease")
+				for i, request := range requests {
+					s.recordLeaseRequest(request, volumes[i].ID, lease.HistoryStateAcked, "gang "+gangID)
+				}
+
+				s.createGangLeases(gangID, requests, volumes)
+
+				return
+			}
+		}
+	}
+
+	s.log.Println("Did not lease gang", gangID)
+}
+
+// createGangLeases creates and assigns the leases of an acked gang, all or
+// nothing. If any step fails, the leases created so far are released and the
+// gang's requests are restored so it can be offered again
+func (s *Server) createGangLeases(gangID string, requests []*lease.LeaseRequest, volumes []*Volume) {
+	leases := []*lease.Lease{}
+
+	rollback := func(deleted []*lease.LeaseRequest, assigned int, cause error) {
+		s.log.Println("Failed to lease gang", gangID, cause)
+
+		for i, l := range leases {
+			var err error
+			switch {
+			case i < assigned:
+				err = s.releaseLease(l, "gang failed: "+cause.Error())
+			case i == assigned && l.Status != lease.LeaseStatusAssigning:
+				// assignLease already released the lease that failed
+			default:
+				// the lease was never associated
+				err = s.b.DeleteLease(l.LeaseID)
+			}
+			if err != nil {
+				s.log.Println(err)
+			}
+		}
+
+		for _, request := range deleted {
+			err := s.b.AddLeaseRequest(request)
+			if err != nil {
+				s.log.Println(err)
+			}
+		}
+
+		go s.iterateLeaseRequests()
+	}
+
+	create := func() error {
+		// other offers to the client may have been claimed since this one was
+		// made
+		unlock, err := s.lockLeaseQuota(requests[0].ClientID, volumes...)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+
+		for i, request := range requests {
+			token, err := s.b.NextFencingToken(volumes[i].ID)
+			if err != nil {
+				return err
+			}
+
+			l := &lease.Lease{
+				LeaseID:        randstr.Hex(16),
+				LeaseRequestID: request.LeaseRequestID,
+				ClientID:       request.ClientID,
+				VolumeID:       volumes[i].ID,
+				Expires:        time.Now().Add(request.TTL),
+				TTL:            request.TTL,
+				GangID:         gangID,
+				Priority:       request.Priority,
+
+				AccessMode:   request.AccessMode,
+				FencingToken: token,
+			}
+
+			err = l.Transition(lease.LeaseStatusAssigning)
+			if err != nil {
+				return err
+			}
+
+			err = s.b.AddLease(l)
+			if err != nil {
+				return err
+			}
+
+			leases = append(leases, l)
+		}
+
+		return nil
+	}
+
+	err := create()
 	if err != nil {
-		s.log.Println(err)
+		rollback(nil, 0, err)
+		return
 	}
+
+	deleted := []*lease.LeaseRequest{}
+	for _, request := range requests {
+		err := s.b.DeleteLeaseRequest(request.LeaseRequestID)
+		if err != nil {
+			rollback(deleted, 0, err)
+			return
+		}
+
+		deleted = append(deleted, request)
+	}
+
+	for i, l := range leases {
+		err := s.assignLease(l)
+		if err != nil {
+			rollback(deleted, i, err)
+			return
+		}
+	}
+
+	granted := []lease.Lease{}
+	for _, l := range leases {
+		granted = append(granted, *l)
+	}
+
+	s.writeNotification(requests[0].ClientID, NewNotification(
+		LeaseNotificationType,
+		LeaseGrantedPayload{
+			GangID:     gangID,
+			GangLeases: granted,
+		},
+	))
 }
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// ttlBounds holds the default TTL for leases and lease requests, and the
+// bounds for TTLs requested by clients
+type ttlBounds struct {
+	def time.Duration
+	min time.Duration
+	max time.Duration
+}
+
+// leaseTTL validates a TTL requested by a client, returning the default TTL
+// if none was requested
+func (s *Server) leaseTTL(d *durationpb.Duration) (time.Duration, error) {
+	if d == nil {
+		return s.ttl.def, nil
+	}
+
+	requested, err := ptypes.Duration(d)
+	if err != nil {
+		return 0, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if requested == 0 {
+		return s.ttl.def, nil
+	}
+
+	if requested < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "ttl %s is negative", requested)
+	}
+
+	if s.ttl.min > 0 && requested < s.ttl.min {
+		return 0, status.Errorf(codes.InvalidArgument, "ttl %s is shorter than the minimum of %s", requested, s.ttl.min)
+	}
+
+	if s.ttl.max > 0 && requested > s.ttl.max {
+		return 0, status.Errorf(codes.InvalidArgument, "ttl %s is longer than the maximum of %s", requested, s.ttl.max)
+	}
+
+	return requested, nil
+}
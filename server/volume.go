@@ -9,6 +9,14 @@ type Volume struct {
 	Tags             []string
 	AvailabilityZone string
 	Status           VolumeStatus
+
+	// FencingToken is the token issued with the most recent lease of the volume
+	FencingToken uint64
+
+	// MultiAttach indicates the volume can be leased read-only by several clients
+	// at once, up to MaxReaders (unlimited if zero)
+	MultiAttach bool
+	MaxReaders  int
 }
 
 const (
@@ -23,6 +31,10 @@ const (
 
 	// LeasedVolumeStatus indicates the volume is currently leased by a client
 	LeasedVolumeStatus
+
+	// SharedLeasedVolumeStatus indicates the volume is currently leased read-only by
+	// one or more clients
+	SharedLeasedVolumeStatus
 )
 
 // VolumeFilterFunc is a function to filter a list of Volumes
@@ -40,3 +52,10 @@ func VolumeFilterByStatus(status VolumeStatus) VolumeFilterFunc {
 		return v.Status == status
 	}
 }
+
+// VolumeFilterLeasable returns volumes that can be offered to lease requests,
+// available volumes and multi-attach volumes that only have shared leases
+func VolumeFilterLeasable(v Volume) bool {
+	return v.Status == AvailableVolumeStatus ||
+		(v.Status == SharedLeasedVolumeStatus && v.MultiAttach)
+}
@@ -0,0 +1,123 @@
+package server
+
+import (
+	"sync"
+
+	"github.com/p0pr0ck5/volchestrator/lease"
+)
+
+// volumeReservations tracks the volumes being offered to lease requests, and
+// serializes volume status updates. A reserved volume stays LeasePending until
+// its offer is resolved, whatever happens to its leases in the meantime
+type volumeReservations struct {
+	// m maps reserved volume IDs to whether their leases changed while they
+	// were reserved
+	m map[string]bool
+	l sync.Mutex
+}
+
+func newVolumeReservations() *volumeReservations {
+	return &volumeReservations{
+		m: make(map[string]bool),
+	}
+}
+
+// reserveVolume marks a volume LeasePending while it is offered, returning
+// the volume as it was before, or nil if it can no longer be leased or is
+// already reserved. Volumes for gangs must also be eligible for gangs
+func (s *Server) reserveVolume(volumeID string, gang bool) (*Volume, error) {
+	s.reservations.l.Lock()
+	defer s.reservations.l.Unlock()
+
+	if _, reserved := s.reservations.m[volumeID]; reserved {
+		return nil, nil
+	}
+
+	volume, err := s.b.GetVolume(volumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if volume == nil || !VolumeFilterLeasable(*volume) {
+		return nil, nil
+	}
+
+	if gang && !gangVolumeEligible(volume) {
+		return nil, nil
+	}
+
+	previous := *volume
+
+	volume.Status = LeasePendingVolumeStatus
+	err = s.b.UpdateVolume(volume)
+	if err != nil {
+		return nil, err
+	}
+
+	s.reservations.m[volumeID] = false
+
+	return &previous, nil
+}
+
+// unreserveVolume ends a volume's offer, setting its status from its leases
+func (s *Server) unreserveVolume(volumeID string) {
+	s.reservations.l.Lock()
+	changed := s.reservations.m[volumeID]
+	delete(s.reservations.m, volumeID)
+	err := s.updateVolumeStatus(volumeID)
+	s.reservations.l.Unlock()
+
+	if err != nil {
+		s.log.Println(err)
+	}
+
+	// a lease released during the offer left the volume to be offered again
+	if changed {
+		go s.iterateLeaseRequests()
+	}
+}
+
+// settleVolume sets a volume's status from its leases after they changed.
+// Reserved volumes stay LeasePending until their offer is resolved
+func (s *Server) settleVolume(volumeID string) error {
+	s.reservations.l.Lock()
+	defer s.reservations.l.Unlock()
+
+	if _, reserved := s.reservations.m[volumeID]; reserved {
+		s.reservations.m[volumeID] = true
+		return nil
+	}
+
+	return s.updateVolumeStatus(volumeID)
+}
+
+// updateVolumeStatus sets a volume's status from its leases, with the
+// reservations held
+func (s *Server) updateVolumeStatus(volumeID string) error {
+	volume, err := s.b.GetVolume(volumeID)
+	if err != nil {
+		return err
+	}
+
+	// the volume was deleted
+	if volume == nil {
+		return nil
+	}
+
+	leases, err := s.b.ListLeases(lease.LeaseFilterByVolume(volumeID))
+	if err != nil {
+		return err
+	}
+
+	volume.Status = AvailableVolumeStatus
+	for _, l := range leases {
+		if l.AccessMode != lease.AccessModeShared {
+			volume.Status = LeasedVolumeStatus
+			break
+		}
+
+		volume.Status = SharedLeasedVolumeStatus
+	}
+
+	return s.b.UpdateVolume(volume)
+}
@@ -0,0 +1,141 @@
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"sync"
+)
+
+// delivery is a payload waiting to be delivered
+type delivery struct {
+	Payload  Payload `json:"payload"`
+	Attempts int     `json:"attempts"`
+}
+
+// queue holds deliveries in order, bounded to a given size, and mirrors them
+// to a file so they survive a restart
+type queue struct {
+	deliveries []delivery
+	size       int
+	path       string
+
+	// wake is signaled when a delivery is added
+	wake chan struct{}
+
+	l sync.Mutex
+}
+
+// newQueue returns a queue, loading any deliveries left in its file
+func newQueue(path string, size int) (*queue, error) {
+	q := &queue{
+		size: size,
+		path: path,
+		wake: make(chan struct{}, 1),
+	}
+
+	if path == "" {
+		return q, nil
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return q, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) > 0 {
+		err = json.Unmarshal(b, &q.deliveries)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return q, nil
+}
+
+// push adds a delivery to the back of the queue, returning the delivery
+// dropped from the front to make room, if any
+func (q *queue) push(d delivery) (*delivery, error) {
+	q.l.Lock()
+	defer q.l.Unlock()
+
+	var dropped *delivery
+	if len(q.deliveries) >= q.size {
+		dropped = &q.deliveries[0]
+		q.deliveries = q.deliveries[1:]
+	}
+
+	q.deliveries = append(q.deliveries, d)
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+
+	return dropped, q.save()
+}
+
+// peek returns the delivery at the front of the queue
+func (q *queue) peek() (delivery, bool) {
+	q.l.Lock()
+	defer q.l.Unlock()
+
+	if len(q.deliveries) == 0 {
+		return delivery{}, false
+	}
+
+	return q.deliveries[0], true
+}
+
+// pop removes a given delivery from the front of the queue. It may have been
+// dropped to make room already
+func (q *queue) pop(id string) error {
+	q.l.Lock()
+	defer q.l.Unlock()
+
+	if len(q.deliveries) == 0 || q.deliveries[0].Payload.ID != id {
+		return nil
+	}
+
+	q.deliveries = q.deliveries[1:]
+
+	return q.save()
+}
+
+// attempted records a failed delivery attempt of a given delivery
+func (q *queue) attempted(id string) (int, error) {
+	q.l.Lock()
+	defer q.l.Unlock()
+
+	if len(q.deliveries) == 0 || q.deliveries[0].Payload.ID != id {
+		return 0, nil
+	}
+
+	q.deliveries[0].Attempts++
+
+	return q.deliveries[0].Attempts, q.save()
+}
+
+// save writes the queue to its file, replacing the previous file atomically
+func (q *queue) save() error {
+	if q.path == "" {
+		return nil
+	}
+
+	b, err := json.Marshal(q.deliveries)
+	if err != nil {
+		return err
+	}
+
+	tmp := q.path + ".tmp"
+	err = ioutil.WriteFile(tmp, b, 0600)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, q.path)
+}
@@ -0,0 +1,394 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/thanhpk/randstr"
+
+	"github.com/p0pr0ck5/volchestrator/server"
+)
+
+const (
+	// DefaultQueueSize is how many deliveries a webhook holds when not
+	// otherwise configured, after which the oldest are dropped
+	DefaultQueueSize = 1000
+
+	// DefaultMaxAttempts is how many times a delivery is attempted when not
+	// otherwise configured
+	DefaultMaxAttempts = 10
+
+	// incomingSize is how many events are buffered on their way to the queue,
+	// after which new events are dropped rather than block the server
+	incomingSize = 256
+
+	// retryBackoff is how long to wait after the first failed attempt,
+	// doubling with each attempt up to maxRetryBackoff
+	retryBackoff    = time.Second
+	maxRetryBackoff = time.Minute * 5
+
+	requestTimeout = time.Second * 10
+)
+
+const (
+	// SignatureHeader holds the hex encoded HMAC-SHA256 of the timestamp
+	// header, a period and the request body, keyed with the shared secret
+	SignatureHeader = "X-Volchestrator-Signature"
+
+	// TimestampHeader holds the Unix time the request was signed at
+	TimestampHeader = "X-Volchestrator-Timestamp"
+
+	// EventHeader holds the event type of the payload
+	EventHeader = "X-Volchestrator-Event"
+
+	// DeliveryHeader holds the payload ID, which is the same for every attempt
+	DeliveryHeader = "X-Volchestrator-Delivery"
+)
+
+// PingEventType is the type of the payload sent when a webhook in test mode starts
+const PingEventType = "ping"
+
+// defaultEvents are the lease and volume lifecycle events sent when a webhook
+// doesn't name any
+var defaultEvents = []server.EventType{
+	server.VolumeAddedEventType,
+	server.VolumeUpdatedEventType,
+	server.VolumeDeletedEventType,
+	server.LeaseRequestSubmittedEventType,
+	server.LeaseRequestExpiredEventType,
+	server.LeaseRequestCancelledEventType,
+	server.LeaseAssignedEventType,
+	server.LeaseReleasedEventType,
+}
+
+// Config configures a Webhook
+type Config struct {
+	Name string
+	URL  string
+
+	// Events are the event types sent, defaulting to lease and volume
+	// lifecycle events
+	Events []server.EventType
+
+	// Secret signs each request when set
+	Secret string
+
+	// QueuePath is the file pending deliveries are kept in. An empty path
+	// keeps them in memory only
+	QueuePath   string
+	QueueSize   int
+	MaxAttempts int
+
+	// TestMode allows plain HTTP to a local server, and sends a ping when
+	// the webhook starts
+	TestMode bool
+}
+
+// Payload is the JSON body POSTed for an event
+type Payload struct {
+	ID   string    `json:"id"`
+	Type string    `json:"type"`
+	Time time.Time `json:"time"`
+
+	ClientID       string `json:"client_id,omitempty"`
+	VolumeID       string `json:"volume_id,omitempty"`
+	LeaseRequestID string `json:"lease_request_id,omitempty"`
+	LeaseID        string `json:"lease_id,omitempty"`
+
+	Message string `json:"message,omitempty"`
+}
+
+// Webhook POSTs server events to an HTTP endpoint, in order, retrying failed
+// deliveries with backoff
+type Webhook struct {
+	c      Config
+	events map[server.EventType]bool
+
+	q *queue
+
+	// incoming hands events to the queue, which persists them off the
+	// server's event path
+	incoming chan Payload
+
+	client *http.Client
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+
+	log *log.Logger
+}
+
+// New returns a Webhook with a given config, loading any deliveries left
+// pending from its queue file
+func New(c Config) (*Webhook, error) {
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook %q url: %w", c.Name, err)
+	}
+
+	switch {
+	case u.Scheme == "https":
+	case u.Scheme == "http" && c.TestMode:
+		if !isLoopback(u.Hostname()) {
+			return nil, fmt.Errorf("webhook %q may only use http with a local server in test mode", c.Name)
+		}
+	default:
+		return nil, fmt.Errorf("webhook %q url must use https", c.Name)
+	}
+
+	if c.QueueSize == 0 {
+		c.QueueSize = DefaultQueueSize
+	}
+
+	if c.MaxAttempts == 0 {
+		c.MaxAttempts = DefaultMaxAttempts
+	}
+
+	events := c.Events
+	if len(events) == 0 {
+		events = defaultEvents
+	}
+
+	w := &Webhook{
+		c:        c,
+		events:   make(map[server.EventType]bool),
+		incoming: make(chan Payload, incomingSize),
+		client:   &http.Client{Timeout: requestTimeout},
+		stop:     make(chan struct{}),
+		log:      log.New(os.Stdout, "", log.Ldate|log.Ltime|log.Lmicroseconds|log.Lshortfile),
+	}
+
+	for _, t := range events {
+		w.events[t] = true
+	}
+
+	w.q, err = newQueue(c.QueuePath, c.QueueSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load webhook %q queue: %w", c.Name, err)
+	}
+
+	return w, nil
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// Handle queues an event for delivery if the webhook is interested in it. It
+// is meant to be passed to server.WithEventHandler, so it never blocks
+func (w *Webhook) Handle(e server.Event) {
+	if !w.events[e.Type] {
+		return
+	}
+
+	p := Payload{
+		ID:             randstr.Hex(16),
+		Type:           e.Type.String(),
+		Time:           e.Time,
+		ClientID:       e.ClientID,
+		VolumeID:       e.VolumeID,
+		LeaseRequestID: e.LeaseRequestID,
+		LeaseID:        e.LeaseID,
+		Message:        e.Message,
+	}
+
+	select {
+	case w.incoming <- p:
+	default:
+		w.log.Printf("Webhook %q is falling behind, dropping %s event", w.c.Name, p.Type)
+	}
+}
+
+// accept queues incoming events until Stop is called, then queues those
+// still buffered so they're persisted
+func (w *Webhook) accept() {
+	defer w.wg.Done()
+
+	for {
+		select {
+		case p := <-w.incoming:
+			w.enqueue(p)
+		case <-w.stop:
+			for {
+				select {
+				case p := <-w.incoming:
+					w.enqueue(p)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (w *Webhook) enqueue(p Payload) {
+	dropped, err := w.q.push(delivery{Payload: p})
+	if dropped != nil {
+		w.log.Printf("Webhook %q queue is full, dropping delivery %s (%s)", w.c.Name, dropped.Payload.ID, dropped.Payload.Type)
+	}
+	if err != nil {
+		w.log.Printf("Failed to persist webhook %q queue: %s", w.c.Name, err)
+	}
+}
+
+// Start delivers queued events in the background until Stop is called
+func (w *Webhook) Start() {
+	if w.c.TestMode {
+		w.enqueue(Payload{
+			ID:   randstr.Hex(16),
+			Type: PingEventType,
+			Time: time.Now(),
+		})
+	}
+
+	w.wg.Add(2)
+	go w.accept()
+	go w.run()
+}
+
+// Stop stops delivering events. Undelivered events stay in the queue file
+func (w *Webhook) Stop() {
+	close(w.stop)
+	w.wg.Wait()
+}
+
+func (w *Webhook) run() {
+	defer w.wg.Done()
+
+	for {
+		d, ok := w.q.peek()
+		if !ok {
+			select {
+			case <-w.stop:
+				return
+			case <-w.q.wake:
+			}
+			continue
+		}
+
+		err := w.deliver(d.Payload)
+		if err == nil {
+			err = w.q.pop(d.Payload.ID)
+			if err != nil {
+				w.log.Printf("Failed to persist webhook %q queue: %s", w.c.Name, err)
+			}
+			continue
+		}
+
+		attempts, qerr := w.q.attempted(d.Payload.ID)
+		if qerr != nil {
+			w.log.Printf("Failed to persist webhook %q queue: %s", w.c.Name, qerr)
+		}
+
+		// the delivery was dropped to make room while it was being attempted
+		if attempts == 0 {
+			continue
+		}
+
+		var permanent *permanentError
+		if errors.As(err, &permanent) || attempts >= w.c.MaxAttempts {
+			w.log.Printf("Giving up on webhook %q delivery %s after %d attempts: %s", w.c.Name, d.Payload.ID, attempts, err)
+
+			err = w.q.pop(d.Payload.ID)
+			if err != nil {
+				w.log.Printf("Failed to persist webhook %q queue: %s", w.c.Name, err)
+			}
+			continue
+		}
+
+		backoff := retryBackoff << (attempts - 1)
+		if backoff <= 0 || backoff > maxRetryBackoff {
+			backoff = maxRetryBackoff
+		}
+
+		w.log.Printf("Webhook %q delivery %s failed, retrying in %s: %s", w.c.Name, d.Payload.ID, backoff, err)
+
+		select {
+		case <-w.stop:
+			return
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// permanentError is a failed delivery that retrying won't fix
+type permanentError struct {
+	status int
+}
+
+func (e *permanentError) Error() string {
+	return fmt.Sprintf("webhook endpoint rejected the delivery with status %d", e.status)
+}
+
+func (w *Webhook) deliver(p Payload) error {
+	body, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, w.c.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, p.Type)
+	req.Header.Set(DeliveryHeader, p.ID)
+	req.Header.Set(TimestampHeader, timestamp)
+	if w.c.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(w.c.Secret, timestamp, body))
+	}
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+
+	switch {
+	case res.StatusCode >= 200 && res.StatusCode < 300:
+		return nil
+	case res.StatusCode == http.StatusRequestTimeout || res.StatusCode == http.StatusTooManyRequests:
+		return fmt.Errorf("webhook endpoint returned status %d", res.StatusCode)
+	case res.StatusCode >= 400 && res.StatusCode < 500:
+		return &permanentError{status: res.StatusCode}
+	}
+
+	return fmt.Errorf("webhook endpoint returned status %d", res.StatusCode)
+}
+
+// Sign returns the signature of a request body sent at a given timestamp
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether a signature matches a request body sent at a given
+// timestamp
+func Verify(secret, timestamp string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
@@ -5,11 +5,14 @@ import (
 	"log"
 	"net"
 	"os"
+	"time"
 
 	"github.com/p0pr0ck5/volchestrator/config"
+	"github.com/p0pr0ck5/volchestrator/lease"
 	"github.com/p0pr0ck5/volchestrator/server"
 	"github.com/p0pr0ck5/volchestrator/server/backend/memory"
 	"github.com/p0pr0ck5/volchestrator/server/resource/timednop"
+	"github.com/p0pr0ck5/volchestrator/server/webhook"
 	svc "github.com/p0pr0ck5/volchestrator/svc"
 	"google.golang.org/grpc"
 )
@@ -22,6 +25,8 @@ type Wrapper struct {
 
 	g *grpc.Server
 
+	webhooks []*webhook.Webhook
+
 	log *log.Logger
 }
 
@@ -31,24 +36,244 @@ func NewWrapper(c config.ServerConfig) (*Wrapper, error) {
 
 	switch c.Backend.Type {
 	case "memory":
-		b = memory.New()
+		opt, err := notificationBufferOption(c.Backend)
+		if err != nil {
+			return nil, err
+		}
+
+		historyOpt, err := historySizeOption(c.Backend)
+		if err != nil {
+			return nil, err
+		}
+
+		b = memory.New(opt, historyOpt)
 	default:
 		return nil, fmt.Errorf("invalid memory type %s", c.Backend.Type)
 	}
 
+	opts := []server.Option{}
+	if c.Quota != nil {
+		opts = append(opts, server.WithQuotas(*c.Quota))
+	}
+
+	if c.Preemption != nil {
+		if c.Preemption.Margin <= 0 {
+			return nil, fmt.Errorf("invalid preemption margin %d, must be positive", c.Preemption.Margin)
+		}
+
+		gracePeriod, err := time.ParseDuration(c.Preemption.GracePeriod)
+		if err != nil {
+			return nil, fmt.Errorf("invalid preemption grace period: %w", err)
+		}
+
+		if gracePeriod <= 0 {
+			return nil, fmt.Errorf("invalid preemption grace period %s, must be positive", gracePeriod)
+		}
+
+		opts = append(opts, server.WithPreemption(c.Preemption.Margin, gracePeriod))
+	}
+
+	if c.DeadClientGracePeriod != "" {
+		gracePeriod, err := time.ParseDuration(c.DeadClientGracePeriod)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dead client grace period: %w", err)
+		}
+
+		if gracePeriod < 0 {
+			return nil, fmt.Errorf("invalid dead client grace period %s, must not be negative", gracePeriod)
+		}
+
+		opts = append(opts, server.WithDeadClientGracePeriod(gracePeriod))
+	}
+
+	if c.ExpiryWarningFraction != 0 {
+		if c.ExpiryWarningFraction < 0 || c.ExpiryWarningFraction >= 1 {
+			return nil, fmt.Errorf("invalid expiry warning fraction %v, must be between 0 and 1", c.ExpiryWarningFraction)
+		}
+
+		opts = append(opts, server.WithExpiryWarning(c.ExpiryWarningFraction))
+	}
+
+	if c.LeaseTTL != nil {
+		opt, err := leaseTTLOption(*c.LeaseTTL)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, opt)
+	}
+
+	if c.MaxLeaseLifetime != nil {
+		opt, err := maxLeaseLifetimeOption(*c.MaxLeaseLifetime)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, opt)
+	}
+
+	webhooks := []*webhook.Webhook{}
+	for _, wc := range c.Webhooks {
+		hook, err := newWebhook(wc)
+		if err != nil {
+			return nil, err
+		}
+
+		webhooks = append(webhooks, hook)
+		opts = append(opts, server.WithEventHandler(hook.Handle))
+	}
+
 	r := timednop.New()
-	s := server.NewServer(b, r)
+	s := server.NewServer(b, r, opts...)
 	s.Init()
 
 	w := &Wrapper{
-		Config: c,
-		Server: s,
-		log:    log.New(os.Stdout, "", log.Ldate|log.Ltime|log.Lmicroseconds|log.Lshortfile),
+		Config:   c,
+		Server:   s,
+		webhooks: webhooks,
+		log:      log.New(os.Stdout, "", log.Ldate|log.Ltime|log.Lmicroseconds|log.Lshortfile),
 	}
 
 	return w, nil
 }
 
+func newWebhook(c config.WebhookConfig) (*webhook.Webhook, error) {
+	wc := webhook.Config{
+		Name:        c.Name,
+		URL:         c.URL,
+		Secret:      c.Secret,
+		QueuePath:   c.QueuePath,
+		QueueSize:   c.QueueSize,
+		MaxAttempts: c.MaxAttempts,
+		TestMode:    c.TestMode,
+	}
+
+	if wc.QueuePath == "" {
+		wc.QueuePath = fmt.Sprintf("webhook-%s.json", c.Name)
+	}
+
+	if wc.QueueSize < 0 || wc.MaxAttempts < 0 {
+		return nil, fmt.Errorf("invalid webhook %q queue size or max attempts", c.Name)
+	}
+
+	for _, e := range c.Events {
+		t, err := server.ParseEventType(e)
+		if err != nil {
+			return nil, fmt.Errorf("invalid webhook %q events: %w", c.Name, err)
+		}
+
+		wc.Events = append(wc.Events, t)
+	}
+
+	return webhook.New(wc)
+}
+
+func notificationBufferOption(c config.BackendConfig) (memory.Option, error) {
+	size := memory.DefaultNotificationBufferSize
+	if c.NotificationBufferSize != 0 {
+		if c.NotificationBufferSize < 0 {
+			return nil, fmt.Errorf("invalid notification buffer size %d", c.NotificationBufferSize)
+		}
+
+		// notifications dropped with the stream are replayed from the history
+		if c.NotificationBufferSize > memory.NotificationHistorySize {
+			return nil, fmt.Errorf("invalid notification buffer size %d, must be at most %d",
+				c.NotificationBufferSize, memory.NotificationHistorySize)
+		}
+
+		size = c.NotificationBufferSize
+	}
+
+	policy := server.OverflowDropStream
+	if c.NotificationOverflowPolicy != "" {
+		var err error
+		policy, err = server.ParseOverflowPolicy(c.NotificationOverflowPolicy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return memory.WithNotificationBuffer(size, policy), nil
+}
+
+func historySizeOption(c config.BackendConfig) (memory.Option, error) {
+	size := memory.DefaultHistorySize
+	if c.HistorySize != 0 {
+		if c.HistorySize < 0 {
+			return nil, fmt.Errorf("invalid history size %d", c.HistorySize)
+		}
+
+		size = c.HistorySize
+	}
+
+	return memory.WithHistorySize(size), nil
+}
+
+func leaseTTLOption(c config.LeaseTTLConfig) (server.Option, error) {
+	def := lease.DefaultLeaseTTL
+	var min, max time.Duration
+	var err error
+
+	if c.Default != "" {
+		def, err = time.ParseDuration(c.Default)
+		if err != nil {
+			return nil, fmt.Errorf("invalid default lease ttl: %w", err)
+		}
+	}
+
+	if c.Min != "" {
+		min, err = time.ParseDuration(c.Min)
+		if err != nil {
+			return nil, fmt.Errorf("invalid min lease ttl: %w", err)
+		}
+	}
+
+	if c.Max != "" {
+		max, err = time.ParseDuration(c.Max)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max lease ttl: %w", err)
+		}
+	}
+
+	if (min > 0 && def < min) || (max > 0 && def > max) {
+		return nil, fmt.Errorf("default lease ttl %s is outside of the min and max bounds", def)
+	}
+
+	return server.WithLeaseTTL(def, min, max), nil
+}
+
+func maxLeaseLifetimeOption(c config.MaxLeaseLifetimeConfig) (server.Option, error) {
+	var lifetime time.Duration
+	var err error
+
+	if c.Lifetime != "" {
+		lifetime, err = time.ParseDuration(c.Lifetime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max lease lifetime: %w", err)
+		}
+	}
+
+	tags := make(map[string]time.Duration)
+	for _, t := range c.Tags {
+		tags[t.Name], err = time.ParseDuration(t.Lifetime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max lease lifetime for tag %q: %w", t.Name, err)
+		}
+	}
+
+	warnings := []time.Duration{}
+	for _, w := range c.Warnings {
+		d, err := time.ParseDuration(w)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max lease lifetime warning: %w", err)
+		}
+
+		warnings = append(warnings, d)
+	}
+
+	return server.WithMaxLeaseLifetime(lifetime, tags, warnings), nil
+}
+
 // Start sets up the listening routines and exits immediately
 func (w *Wrapper) Start() error {
 	address := w.Config.Listen.Address
@@ -65,6 +290,10 @@ func (w *Wrapper) Start() error {
 	w.g = grpcServer
 	go grpcServer.Serve(listen) // TODO cleanup
 
+	for _, hook := range w.webhooks {
+		hook.Start()
+	}
+
 	return nil
 }
 
@@ -74,5 +303,9 @@ func (w *Wrapper) Stop() error {
 
 	w.g.GracefulStop()
 
+	for _, hook := range w.webhooks {
+		hook.Stop()
+	}
+
 	return nil
 }
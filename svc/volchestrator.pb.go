@@ -9,6 +9,7 @@ package svc
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -24,11 +25,16 @@ const (
 type NotificationType int32
 
 const (
-	NotificationType_NOTIFICATIONUNKNOWN             NotificationType = 0
-	NotificationType_NOTIFICATIONLEASEREQUESTACK     NotificationType = 1
-	NotificationType_NOTIFICATIONLEASEREQUESTEXPIRED NotificationType = 2
-	NotificationType_NOTIFICATIONLEASEAVAILABLE      NotificationType = 3
-	NotificationType_NOTIFICATIONLEASE               NotificationType = 4
+	NotificationType_NOTIFICATIONUNKNOWN              NotificationType = 0
+	NotificationType_NOTIFICATIONLEASEREQUESTACK      NotificationType = 1
+	NotificationType_NOTIFICATIONLEASEREQUESTEXPIRED  NotificationType = 2
+	NotificationType_NOTIFICATIONLEASEAVAILABLE       NotificationType = 3
+	NotificationType_NOTIFICATIONLEASE                NotificationType = 4
+	NotificationType_NOTIFICATIONLEASEREVOKED         NotificationType = 5
+	NotificationType_NOTIFICATIONLEASERELEASED        NotificationType = 6
+	NotificationType_NOTIFICATIONLEASETRANSFERRED     NotificationType = 7
+	NotificationType_NOTIFICATIONLEASELIFETIMEWARNING NotificationType = 8
+	NotificationType_NOTIFICATIONLEASEEXPIRING        NotificationType = 9
 )
 
 // Enum value maps for NotificationType.
@@ -39,13 +45,23 @@ var (
 		2: "NOTIFICATIONLEASEREQUESTEXPIRED",
 		3: "NOTIFICATIONLEASEAVAILABLE",
 		4: "NOTIFICATIONLEASE",
+		5: "NOTIFICATIONLEASEREVOKED",
+		6: "NOTIFICATIONLEASERELEASED",
+		7: "NOTIFICATIONLEASETRANSFERRED",
+		8: "NOTIFICATIONLEASELIFETIMEWARNING",
+		9: "NOTIFICATIONLEASEEXPIRING",
 	}
 	NotificationType_value = map[string]int32{
-		"NOTIFICATIONUNKNOWN":             0,
-		"NOTIFICATIONLEASEREQUESTACK":     1,
-		"NOTIFICATIONLEASEREQUESTEXPIRED": 2,
-		"NOTIFICATIONLEASEAVAILABLE":      3,
-		"NOTIFICATIONLEASE":               4,
+		"NOTIFICATIONUNKNOWN":              0,
+		"NOTIFICATIONLEASEREQUESTACK":      1,
+		"NOTIFICATIONLEASEREQUESTEXPIRED":  2,
+		"NOTIFICATIONLEASEAVAILABLE":       3,
+		"NOTIFICATIONLEASE":                4,
+		"NOTIFICATIONLEASEREVOKED":         5,
+		"NOTIFICATIONLEASERELEASED":        6,
+		"NOTIFICATIONLEASETRANSFERRED":     7,
+		"NOTIFICATIONLEASELIFETIMEWARNING": 8,
+		"NOTIFICATIONLEASEEXPIRING":        9,
 	}
 )
 
@@ -76,13 +92,60 @@ func (NotificationType) EnumDescriptor() ([]byte, []int) {
 	return file_svc_volchestrator_proto_rawDescGZIP(), []int{0}
 }
 
+type LeaseAccessMode int32
+
+const (
+	LeaseAccessMode_LEASEACCESSEXCLUSIVE LeaseAccessMode = 0
+	LeaseAccessMode_LEASEACCESSSHARED    LeaseAccessMode = 1
+)
+
+// Enum value maps for LeaseAccessMode.
+var (
+	LeaseAccessMode_name = map[int32]string{
+		0: "LEASEACCESSEXCLUSIVE",
+		1: "LEASEACCESSSHARED",
+	}
+	LeaseAccessMode_value = map[string]int32{
+		"LEASEACCESSEXCLUSIVE": 0,
+		"LEASEACCESSSHARED":    1,
+	}
+)
+
+func (x LeaseAccessMode) Enum() *LeaseAccessMode {
+	p := new(LeaseAccessMode)
+	*p = x
+	return p
+}
+
+func (x LeaseAccessMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaseAccessMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_svc_volchestrator_proto_enumTypes[1].Descriptor()
+}
+
+func (LeaseAccessMode) Type() protoreflect.EnumType {
+	return &file_svc_volchestrator_proto_enumTypes[1]
+}
+
+func (x LeaseAccessMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaseAccessMode.Descriptor instead.
+func (LeaseAccessMode) EnumDescriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{1}
+}
+
 type ClientStatus int32
 
 const (
-	ClientStatus_CLIENTUNKNOWN ClientStatus = 0
-	ClientStatus_CLIENTALIVE   ClientStatus = 1
-	ClientStatus_CLIENTDEAD    ClientStatus = 2
-	ClientStatus_CLIENTLEFT    ClientStatus = 3
+	ClientStatus_CLIENTUNKNOWN   ClientStatus = 0
+	ClientStatus_CLIENTALIVE     ClientStatus = 1
+	ClientStatus_CLIENTDEAD      ClientStatus = 2
+	ClientStatus_CLIENTLEFT      ClientStatus = 3
+	ClientStatus_CLIENTUNHEALTHY ClientStatus = 4
 )
 
 // Enum value maps for ClientStatus.
@@ -92,12 +155,14 @@ var (
 		1: "CLIENTALIVE",
 		2: "CLIENTDEAD",
 		3: "CLIENTLEFT",
+		4: "CLIENTUNHEALTHY",
 	}
 	ClientStatus_value = map[string]int32{
-		"CLIENTUNKNOWN": 0,
-		"CLIENTALIVE":   1,
-		"CLIENTDEAD":    2,
-		"CLIENTLEFT":    3,
+		"CLIENTUNKNOWN":   0,
+		"CLIENTALIVE":     1,
+		"CLIENTDEAD":      2,
+		"CLIENTLEFT":      3,
+		"CLIENTUNHEALTHY": 4,
 	}
 )
 
@@ -112,11 +177,11 @@ func (x ClientStatus) String() string {
 }
 
 func (ClientStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_svc_volchestrator_proto_enumTypes[1].Descriptor()
+	return file_svc_volchestrator_proto_enumTypes[2].Descriptor()
 }
 
 func (ClientStatus) Type() protoreflect.EnumType {
-	return &file_svc_volchestrator_proto_enumTypes[1]
+	return &file_svc_volchestrator_proto_enumTypes[2]
 }
 
 func (x ClientStatus) Number() protoreflect.EnumNumber {
@@ -125,7 +190,7 @@ func (x ClientStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ClientStatus.Descriptor instead.
 func (ClientStatus) EnumDescriptor() ([]byte, []int) {
-	return file_svc_volchestrator_proto_rawDescGZIP(), []int{1}
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{2}
 }
 
 type VolumeStatus int32
@@ -135,6 +200,7 @@ const (
 	VolumeStatus_VOLUMEAVAILABLE    VolumeStatus = 1
 	VolumeStatus_VOLUMELEASEPENDING VolumeStatus = 2
 	VolumeStatus_VOLUMELEASED       VolumeStatus = 3
+	VolumeStatus_VOLUMESHAREDLEASED VolumeStatus = 4
 )
 
 // Enum value maps for VolumeStatus.
@@ -144,12 +210,14 @@ var (
 		1: "VOLUMEAVAILABLE",
 		2: "VOLUMELEASEPENDING",
 		3: "VOLUMELEASED",
+		4: "VOLUMESHAREDLEASED",
 	}
 	VolumeStatus_value = map[string]int32{
 		"VOLUMEUNKNOWN":      0,
 		"VOLUMEAVAILABLE":    1,
 		"VOLUMELEASEPENDING": 2,
 		"VOLUMELEASED":       3,
+		"VOLUMESHAREDLEASED": 4,
 	}
 )
 
@@ -164,11 +232,11 @@ func (x VolumeStatus) String() string {
 }
 
 func (VolumeStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_svc_volchestrator_proto_enumTypes[2].Descriptor()
+	return file_svc_volchestrator_proto_enumTypes[3].Descriptor()
 }
 
 func (VolumeStatus) Type() protoreflect.EnumType {
-	return &file_svc_volchestrator_proto_enumTypes[2]
+	return &file_svc_volchestrator_proto_enumTypes[3]
 }
 
 func (x VolumeStatus) Number() protoreflect.EnumNumber {
@@ -177,16 +245,18 @@ func (x VolumeStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use VolumeStatus.Descriptor instead.
 func (VolumeStatus) EnumDescriptor() ([]byte, []int) {
-	return file_svc_volchestrator_proto_rawDescGZIP(), []int{2}
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{3}
 }
 
 type LeaseStatus int32
 
 const (
-	LeaseStatus_LEASEUNKNOWN   LeaseStatus = 0
-	LeaseStatus_LEASEASSIGNING LeaseStatus = 1
-	LeaseStatus_LEASEASSIGNED  LeaseStatus = 2
-	LeaseStatus_LEASERELEASING LeaseStatus = 3
+	LeaseStatus_LEASEUNKNOWN      LeaseStatus = 0
+	LeaseStatus_LEASEASSIGNING    LeaseStatus = 1
+	LeaseStatus_LEASEASSIGNED     LeaseStatus = 2
+	LeaseStatus_LEASERELEASING    LeaseStatus = 3
+	LeaseStatus_LEASEORPHANED     LeaseStatus = 4
+	LeaseStatus_LEASETRANSFERRING LeaseStatus = 5
 )
 
 // Enum value maps for LeaseStatus.
@@ -196,12 +266,16 @@ var (
 		1: "LEASEASSIGNING",
 		2: "LEASEASSIGNED",
 		3: "LEASERELEASING",
+		4: "LEASEORPHANED",
+		5: "LEASETRANSFERRING",
 	}
 	LeaseStatus_value = map[string]int32{
-		"LEASEUNKNOWN":   0,
-		"LEASEASSIGNING": 1,
-		"LEASEASSIGNED":  2,
-		"LEASERELEASING": 3,
+		"LEASEUNKNOWN":      0,
+		"LEASEASSIGNING":    1,
+		"LEASEASSIGNED":     2,
+		"LEASERELEASING":    3,
+		"LEASEORPHANED":     4,
+		"LEASETRANSFERRING": 5,
 	}
 )
 
@@ -216,11 +290,11 @@ func (x LeaseStatus) String() string {
 }
 
 func (LeaseStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_svc_volchestrator_proto_enumTypes[3].Descriptor()
+	return file_svc_volchestrator_proto_enumTypes[4].Descriptor()
 }
 
 func (LeaseStatus) Type() protoreflect.EnumType {
-	return &file_svc_volchestrator_proto_enumTypes[3]
+	return &file_svc_volchestrator_proto_enumTypes[4]
 }
 
 func (x LeaseStatus) Number() protoreflect.EnumNumber {
@@ -229,7 +303,171 @@ func (x LeaseStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LeaseStatus.Descriptor instead.
 func (LeaseStatus) EnumDescriptor() ([]byte, []int) {
-	return file_svc_volchestrator_proto_rawDescGZIP(), []int{3}
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{4}
+}
+
+type HistoryState int32
+
+const (
+	HistoryState_HISTORYUNKNOWN   HistoryState = 0
+	HistoryState_HISTORYREQUESTED HistoryState = 1
+	HistoryState_HISTORYOFFERED   HistoryState = 2
+	HistoryState_HISTORYACKED     HistoryState = 3
+	HistoryState_HISTORYASSIGNING HistoryState = 4
+	HistoryState_HISTORYASSIGNED  HistoryState = 5
+	HistoryState_HISTORYRELEASING HistoryState = 6
+	HistoryState_HISTORYRELEASED  HistoryState = 7
+	HistoryState_HISTORYEXPIRED   HistoryState = 8
+	HistoryState_HISTORYFAILED    HistoryState = 9
+	HistoryState_HISTORYUPDATED   HistoryState = 10
+	HistoryState_HISTORYCANCELLED HistoryState = 11
+)
+
+// Enum value maps for HistoryState.
+var (
+	HistoryState_name = map[int32]string{
+		0:  "HISTORYUNKNOWN",
+		1:  "HISTORYREQUESTED",
+		2:  "HISTORYOFFERED",
+		3:  "HISTORYACKED",
+		4:  "HISTORYASSIGNING",
+		5:  "HISTORYASSIGNED",
+		6:  "HISTORYRELEASING",
+		7:  "HISTORYRELEASED",
+		8:  "HISTORYEXPIRED",
+		9:  "HISTORYFAILED",
+		10: "HISTORYUPDATED",
+		11: "HISTORYCANCELLED",
+	}
+	HistoryState_value = map[string]int32{
+		"HISTORYUNKNOWN":   0,
+		"HISTORYREQUESTED": 1,
+		"HISTORYOFFERED":   2,
+		"HISTORYACKED":     3,
+		"HISTORYASSIGNING": 4,
+		"HISTORYASSIGNED":  5,
+		"HISTORYRELEASING": 6,
+		"HISTORYRELEASED":  7,
+		"HISTORYEXPIRED":   8,
+		"HISTORYFAILED":    9,
+		"HISTORYUPDATED":   10,
+		"HISTORYCANCELLED": 11,
+	}
+)
+
+func (x HistoryState) Enum() *HistoryState {
+	p := new(HistoryState)
+	*p = x
+	return p
+}
+
+func (x HistoryState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HistoryState) Descriptor() protoreflect.EnumDescriptor {
+	return file_svc_volchestrator_proto_enumTypes[5].Descriptor()
+}
+
+func (HistoryState) Type() protoreflect.EnumType {
+	return &file_svc_volchestrator_proto_enumTypes[5]
+}
+
+func (x HistoryState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HistoryState.Descriptor instead.
+func (HistoryState) EnumDescriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{5}
+}
+
+type EventType int32
+
+const (
+	EventType_EVENTUNKNOWN               EventType = 0
+	EventType_EVENTCLIENTREGISTERED      EventType = 1
+	EventType_EVENTCLIENTDEREGISTERED    EventType = 2
+	EventType_EVENTCLIENTDEAD            EventType = 3
+	EventType_EVENTVOLUMEADDED           EventType = 4
+	EventType_EVENTVOLUMEUPDATED         EventType = 5
+	EventType_EVENTVOLUMEDELETED         EventType = 6
+	EventType_EVENTLEASEREQUESTSUBMITTED EventType = 7
+	EventType_EVENTLEASEREQUESTEXPIRED   EventType = 8
+	EventType_EVENTLEASEOFFERED          EventType = 9
+	EventType_EVENTLEASEACKED            EventType = 10
+	EventType_EVENTLEASEASSIGNED         EventType = 11
+	EventType_EVENTLEASERELEASED         EventType = 12
+	EventType_EVENTRESOURCEERROR         EventType = 13
+	EventType_EVENTLEASEREQUESTUPDATED   EventType = 14
+	EventType_EVENTLEASEREQUESTCANCELLED EventType = 15
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0:  "EVENTUNKNOWN",
+		1:  "EVENTCLIENTREGISTERED",
+		2:  "EVENTCLIENTDEREGISTERED",
+		3:  "EVENTCLIENTDEAD",
+		4:  "EVENTVOLUMEADDED",
+		5:  "EVENTVOLUMEUPDATED",
+		6:  "EVENTVOLUMEDELETED",
+		7:  "EVENTLEASEREQUESTSUBMITTED",
+		8:  "EVENTLEASEREQUESTEXPIRED",
+		9:  "EVENTLEASEOFFERED",
+		10: "EVENTLEASEACKED",
+		11: "EVENTLEASEASSIGNED",
+		12: "EVENTLEASERELEASED",
+		13: "EVENTRESOURCEERROR",
+		14: "EVENTLEASEREQUESTUPDATED",
+		15: "EVENTLEASEREQUESTCANCELLED",
+	}
+	EventType_value = map[string]int32{
+		"EVENTUNKNOWN":               0,
+		"EVENTCLIENTREGISTERED":      1,
+		"EVENTCLIENTDEREGISTERED":    2,
+		"EVENTCLIENTDEAD":            3,
+		"EVENTVOLUMEADDED":           4,
+		"EVENTVOLUMEUPDATED":         5,
+		"EVENTVOLUMEDELETED":         6,
+		"EVENTLEASEREQUESTSUBMITTED": 7,
+		"EVENTLEASEREQUESTEXPIRED":   8,
+		"EVENTLEASEOFFERED":          9,
+		"EVENTLEASEACKED":            10,
+		"EVENTLEASEASSIGNED":         11,
+		"EVENTLEASERELEASED":         12,
+		"EVENTRESOURCEERROR":         13,
+		"EVENTLEASEREQUESTUPDATED":   14,
+		"EVENTLEASEREQUESTCANCELLED": 15,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_svc_volchestrator_proto_enumTypes[6].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_svc_volchestrator_proto_enumTypes[6]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{6}
 }
 
 type RegisterMessage struct {
@@ -425,9 +663,14 @@ type LeaseRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClientId         string `protobuf:"bytes,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
-	Tag              string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
-	AvailabilityZone string `protobuf:"bytes,3,opt,name=availabilityZone,proto3" json:"availabilityZone,omitempty"`
+	ClientId         string               `protobuf:"bytes,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	Tag              string               `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	AvailabilityZone string               `protobuf:"bytes,3,opt,name=availabilityZone,proto3" json:"availabilityZone,omitempty"`
+	VolumeId         string               `protobuf:"bytes,4,opt,name=volumeId,proto3" json:"volumeId,omitempty"`
+	Priority         int32                `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	Ttl              *durationpb.Duration `protobuf:"bytes,6,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	AccessMode       LeaseAccessMode      `protobuf:"varint,7,opt,name=accessMode,proto3,enum=volchestrator.LeaseAccessMode" json:"accessMode,omitempty"`
+	IdempotencyKey   string               `protobuf:"bytes,8,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
 func (x *LeaseRequest) Reset() {
@@ -483,80 +726,69 @@ func (x *LeaseRequest) GetAvailabilityZone() string {
 	return ""
 }
 
-type NotificationWatchMessage struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-}
-
-func (x *NotificationWatchMessage) Reset() {
-	*x = NotificationWatchMessage{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_svc_volchestrator_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *LeaseRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
 	}
+	return ""
 }
 
-func (x *NotificationWatchMessage) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *LeaseRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
 }
 
-func (*NotificationWatchMessage) ProtoMessage() {}
-
-func (x *NotificationWatchMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_svc_volchestrator_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *LeaseRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use NotificationWatchMessage.ProtoReflect.Descriptor instead.
-func (*NotificationWatchMessage) Descriptor() ([]byte, []int) {
-	return file_svc_volchestrator_proto_rawDescGZIP(), []int{5}
+func (x *LeaseRequest) GetAccessMode() LeaseAccessMode {
+	if x != nil {
+		return x.AccessMode
+	}
+	return LeaseAccessMode_LEASEACCESSEXCLUSIVE
 }
 
-func (x *NotificationWatchMessage) GetId() string {
+func (x *LeaseRequest) GetIdempotencyKey() string {
 	if x != nil {
-		return x.Id
+		return x.IdempotencyKey
 	}
 	return ""
 }
 
-type Notification struct {
+type GangLeaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type    NotificationType `protobuf:"varint,2,opt,name=type,proto3,enum=volchestrator.NotificationType" json:"type,omitempty"`
-	Message string           `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	ClientId       string               `protobuf:"bytes,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	Requests       []*LeaseRequest      `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	Ttl            *durationpb.Duration `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	IdempotencyKey string               `protobuf:"bytes,4,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
-func (x *Notification) Reset() {
-	*x = Notification{}
+func (x *GangLeaseRequest) Reset() {
+	*x = GangLeaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_svc_volchestrator_proto_msgTypes[6]
+		mi := &file_svc_volchestrator_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Notification) String() string {
+func (x *GangLeaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Notification) ProtoMessage() {}
+func (*GangLeaseRequest) ProtoMessage() {}
 
-func (x *Notification) ProtoReflect() protoreflect.Message {
-	mi := &file_svc_volchestrator_proto_msgTypes[6]
+func (x *GangLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,57 +799,65 @@ func (x *Notification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
-func (*Notification) Descriptor() ([]byte, []int) {
-	return file_svc_volchestrator_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GangLeaseRequest.ProtoReflect.Descriptor instead.
+func (*GangLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Notification) GetId() string {
+func (x *GangLeaseRequest) GetClientId() string {
 	if x != nil {
-		return x.Id
+		return x.ClientId
 	}
 	return ""
 }
 
-func (x *Notification) GetType() NotificationType {
+func (x *GangLeaseRequest) GetRequests() []*LeaseRequest {
 	if x != nil {
-		return x.Type
+		return x.Requests
 	}
-	return NotificationType_NOTIFICATIONUNKNOWN
+	return nil
 }
 
-func (x *Notification) GetMessage() string {
+func (x *GangLeaseRequest) GetTtl() *durationpb.Duration {
 	if x != nil {
-		return x.Message
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *GangLeaseRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
 	}
 	return ""
 }
 
-type Acknowledgement struct {
+type NotificationWatchMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ResumeAfter uint64 `protobuf:"varint,2,opt,name=resumeAfter,proto3" json:"resumeAfter,omitempty"`
 }
 
-func (x *Acknowledgement) Reset() {
-	*x = Acknowledgement{}
+func (x *NotificationWatchMessage) Reset() {
+	*x = NotificationWatchMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_svc_volchestrator_proto_msgTypes[7]
+		mi := &file_svc_volchestrator_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Acknowledgement) String() string {
+func (x *NotificationWatchMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Acknowledgement) ProtoMessage() {}
+func (*NotificationWatchMessage) ProtoMessage() {}
 
-func (x *Acknowledgement) ProtoReflect() protoreflect.Message {
-	mi := &file_svc_volchestrator_proto_msgTypes[7]
+func (x *NotificationWatchMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -628,46 +868,64 @@ func (x *Acknowledgement) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Acknowledgement.ProtoReflect.Descriptor instead.
-func (*Acknowledgement) Descriptor() ([]byte, []int) {
-	return file_svc_volchestrator_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use NotificationWatchMessage.ProtoReflect.Descriptor instead.
+func (*NotificationWatchMessage) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Acknowledgement) GetId() string {
+func (x *NotificationWatchMessage) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type ClientInfo struct {
+func (x *NotificationWatchMessage) GetResumeAfter() uint64 {
+	if x != nil {
+		return x.ResumeAfter
+	}
+	return 0
+}
+
+type Notification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ClientStatus ClientStatus           `protobuf:"varint,2,opt,name=clientStatus,proto3,enum=volchestrator.ClientStatus" json:"clientStatus,omitempty"`
-	FirstSeen    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=firstSeen,proto3" json:"firstSeen,omitempty"`
-	LastSeen     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=lastSeen,proto3" json:"lastSeen,omitempty"`
+	Id       string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type     NotificationType `protobuf:"varint,2,opt,name=type,proto3,enum=volchestrator.NotificationType" json:"type,omitempty"`
+	Sequence uint64           `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Types that are assignable to Payload:
+	//	*Notification_ClientRegistered
+	//	*Notification_LeaseRequestAck
+	//	*Notification_LeaseRequestExpired
+	//	*Notification_LeaseAvailable
+	//	*Notification_LeaseGranted
+	//	*Notification_LeaseRevoked
+	//	*Notification_LeaseReleased
+	//	*Notification_LeaseTransferred
+	//	*Notification_LeaseLifetimeWarning
+	//	*Notification_LeaseExpiring
+	Payload isNotification_Payload `protobuf_oneof:"payload"`
 }
 
-func (x *ClientInfo) Reset() {
-	*x = ClientInfo{}
+func (x *Notification) Reset() {
+	*x = Notification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_svc_volchestrator_proto_msgTypes[8]
+		mi := &file_svc_volchestrator_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClientInfo) String() string {
+func (x *Notification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClientInfo) ProtoMessage() {}
+func (*Notification) ProtoMessage() {}
 
-func (x *ClientInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_svc_volchestrator_proto_msgTypes[8]
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -678,63 +936,245 @@ func (x *ClientInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClientInfo.ProtoReflect.Descriptor instead.
-func (*ClientInfo) Descriptor() ([]byte, []int) {
-	return file_svc_volchestrator_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ClientInfo) GetId() string {
+func (x *Notification) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ClientInfo) GetClientStatus() ClientStatus {
+func (x *Notification) GetType() NotificationType {
 	if x != nil {
-		return x.ClientStatus
+		return x.Type
 	}
-	return ClientStatus_CLIENTUNKNOWN
+	return NotificationType_NOTIFICATIONUNKNOWN
 }
 
-func (x *ClientInfo) GetFirstSeen() *timestamppb.Timestamp {
+func (x *Notification) GetSequence() uint64 {
 	if x != nil {
-		return x.FirstSeen
+		return x.Sequence
 	}
-	return nil
+	return 0
 }
 
-func (x *ClientInfo) GetLastSeen() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastSeen
+func (m *Notification) GetPayload() isNotification_Payload {
+	if m != nil {
+		return m.Payload
 	}
 	return nil
 }
 
-type ClientList struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Notification) GetClientRegistered() *ClientRegisteredPayload {
+	if x, ok := x.GetPayload().(*Notification_ClientRegistered); ok {
+		return x.ClientRegistered
+	}
+	return nil
+}
 
-	Info []*ClientInfo `protobuf:"bytes,1,rep,name=info,proto3" json:"info,omitempty"`
+func (x *Notification) GetLeaseRequestAck() *LeaseRequestAckPayload {
+	if x, ok := x.GetPayload().(*Notification_LeaseRequestAck); ok {
+		return x.LeaseRequestAck
+	}
+	return nil
 }
 
-func (x *ClientList) Reset() {
-	*x = ClientList{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_svc_volchestrator_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Notification) GetLeaseRequestExpired() *LeaseRequestExpiredPayload {
+	if x, ok := x.GetPayload().(*Notification_LeaseRequestExpired); ok {
+		return x.LeaseRequestExpired
 	}
+	return nil
 }
 
-func (x *ClientList) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Notification) GetLeaseAvailable() *LeaseAvailablePayload {
+	if x, ok := x.GetPayload().(*Notification_LeaseAvailable); ok {
+		return x.LeaseAvailable
+	}
+	return nil
 }
 
-func (*ClientList) ProtoMessage() {}
+func (x *Notification) GetLeaseGranted() *LeaseGrantedPayload {
+	if x, ok := x.GetPayload().(*Notification_LeaseGranted); ok {
+		return x.LeaseGranted
+	}
+	return nil
+}
 
-func (x *ClientList) ProtoReflect() protoreflect.Message {
+func (x *Notification) GetLeaseRevoked() *LeaseRevokedPayload {
+	if x, ok := x.GetPayload().(*Notification_LeaseRevoked); ok {
+		return x.LeaseRevoked
+	}
+	return nil
+}
+
+func (x *Notification) GetLeaseReleased() *LeaseReleasedPayload {
+	if x, ok := x.GetPayload().(*Notification_LeaseReleased); ok {
+		return x.LeaseReleased
+	}
+	return nil
+}
+
+func (x *Notification) GetLeaseTransferred() *LeaseTransferredPayload {
+	if x, ok := x.GetPayload().(*Notification_LeaseTransferred); ok {
+		return x.LeaseTransferred
+	}
+	return nil
+}
+
+func (x *Notification) GetLeaseLifetimeWarning() *LeaseLifetimeWarningPayload {
+	if x, ok := x.GetPayload().(*Notification_LeaseLifetimeWarning); ok {
+		return x.LeaseLifetimeWarning
+	}
+	return nil
+}
+
+func (x *Notification) GetLeaseExpiring() *LeaseExpiringPayload {
+	if x, ok := x.GetPayload().(*Notification_LeaseExpiring); ok {
+		return x.LeaseExpiring
+	}
+	return nil
+}
+
+type isNotification_Payload interface {
+	isNotification_Payload()
+}
+
+type Notification_ClientRegistered struct {
+	ClientRegistered *ClientRegisteredPayload `protobuf:"bytes,5,opt,name=clientRegistered,proto3,oneof"`
+}
+
+type Notification_LeaseRequestAck struct {
+	LeaseRequestAck *LeaseRequestAckPayload `protobuf:"bytes,6,opt,name=leaseRequestAck,proto3,oneof"`
+}
+
+type Notification_LeaseRequestExpired struct {
+	LeaseRequestExpired *LeaseRequestExpiredPayload `protobuf:"bytes,7,opt,name=leaseRequestExpired,proto3,oneof"`
+}
+
+type Notification_LeaseAvailable struct {
+	LeaseAvailable *LeaseAvailablePayload `protobuf:"bytes,8,opt,name=leaseAvailable,proto3,oneof"`
+}
+
+type Notification_LeaseGranted struct {
+	LeaseGranted *LeaseGrantedPayload `protobuf:"bytes,9,opt,name=leaseGranted,proto3,oneof"`
+}
+
+type Notification_LeaseRevoked struct {
+	LeaseRevoked *LeaseRevokedPayload `protobuf:"bytes,10,opt,name=leaseRevoked,proto3,oneof"`
+}
+
+type Notification_LeaseReleased struct {
+	LeaseReleased *LeaseReleasedPayload `protobuf:"bytes,11,opt,name=leaseReleased,proto3,oneof"`
+}
+
+type Notification_LeaseTransferred struct {
+	LeaseTransferred *LeaseTransferredPayload `protobuf:"bytes,12,opt,name=leaseTransferred,proto3,oneof"`
+}
+
+type Notification_LeaseLifetimeWarning struct {
+	LeaseLifetimeWarning *LeaseLifetimeWarningPayload `protobuf:"bytes,13,opt,name=leaseLifetimeWarning,proto3,oneof"`
+}
+
+type Notification_LeaseExpiring struct {
+	LeaseExpiring *LeaseExpiringPayload `protobuf:"bytes,14,opt,name=leaseExpiring,proto3,oneof"`
+}
+
+func (*Notification_ClientRegistered) isNotification_Payload() {}
+
+func (*Notification_LeaseRequestAck) isNotification_Payload() {}
+
+func (*Notification_LeaseRequestExpired) isNotification_Payload() {}
+
+func (*Notification_LeaseAvailable) isNotification_Payload() {}
+
+func (*Notification_LeaseGranted) isNotification_Payload() {}
+
+func (*Notification_LeaseRevoked) isNotification_Payload() {}
+
+func (*Notification_LeaseReleased) isNotification_Payload() {}
+
+func (*Notification_LeaseTransferred) isNotification_Payload() {}
+
+func (*Notification_LeaseLifetimeWarning) isNotification_Payload() {}
+
+func (*Notification_LeaseExpiring) isNotification_Payload() {}
+
+type ClientRegisteredPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId string `protobuf:"bytes,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+}
+
+func (x *ClientRegisteredPayload) Reset() {
+	*x = ClientRegisteredPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientRegisteredPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientRegisteredPayload) ProtoMessage() {}
+
+func (x *ClientRegisteredPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientRegisteredPayload.ProtoReflect.Descriptor instead.
+func (*ClientRegisteredPayload) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ClientRegisteredPayload) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type LeaseRequestAckPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseRequestId string `protobuf:"bytes,1,opt,name=leaseRequestId,proto3" json:"leaseRequestId,omitempty"`
+	GangId         string `protobuf:"bytes,2,opt,name=gangId,proto3" json:"gangId,omitempty"`
+}
+
+func (x *LeaseRequestAckPayload) Reset() {
+	*x = LeaseRequestAckPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseRequestAckPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseRequestAckPayload) ProtoMessage() {}
+
+func (x *LeaseRequestAckPayload) ProtoReflect() protoreflect.Message {
 	mi := &file_svc_volchestrator_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -746,26 +1186,36 @@ func (x *ClientList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClientList.ProtoReflect.Descriptor instead.
-func (*ClientList) Descriptor() ([]byte, []int) {
+// Deprecated: Use LeaseRequestAckPayload.ProtoReflect.Descriptor instead.
+func (*LeaseRequestAckPayload) Descriptor() ([]byte, []int) {
 	return file_svc_volchestrator_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ClientList) GetInfo() []*ClientInfo {
+func (x *LeaseRequestAckPayload) GetLeaseRequestId() string {
 	if x != nil {
-		return x.Info
+		return x.LeaseRequestId
 	}
-	return nil
+	return ""
 }
 
-type Empty struct {
+func (x *LeaseRequestAckPayload) GetGangId() string {
+	if x != nil {
+		return x.GangId
+	}
+	return ""
+}
+
+type LeaseRequestExpiredPayload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	LeaseRequestId string `protobuf:"bytes,1,opt,name=leaseRequestId,proto3" json:"leaseRequestId,omitempty"`
+	GangId         string `protobuf:"bytes,2,opt,name=gangId,proto3" json:"gangId,omitempty"`
 }
 
-func (x *Empty) Reset() {
-	*x = Empty{}
+func (x *LeaseRequestExpiredPayload) Reset() {
+	*x = LeaseRequestExpiredPayload{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_svc_volchestrator_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -773,13 +1223,13 @@ func (x *Empty) Reset() {
 	}
 }
 
-func (x *Empty) String() string {
+func (x *LeaseRequestExpiredPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Empty) ProtoMessage() {}
+func (*LeaseRequestExpiredPayload) ProtoMessage() {}
 
-func (x *Empty) ProtoReflect() protoreflect.Message {
+func (x *LeaseRequestExpiredPayload) ProtoReflect() protoreflect.Message {
 	mi := &file_svc_volchestrator_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -791,21 +1241,37 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
+// Deprecated: Use LeaseRequestExpiredPayload.ProtoReflect.Descriptor instead.
+func (*LeaseRequestExpiredPayload) Descriptor() ([]byte, []int) {
 	return file_svc_volchestrator_proto_rawDescGZIP(), []int{10}
 }
 
-type VolumeID struct {
+func (x *LeaseRequestExpiredPayload) GetLeaseRequestId() string {
+	if x != nil {
+		return x.LeaseRequestId
+	}
+	return ""
+}
+
+func (x *LeaseRequestExpiredPayload) GetGangId() string {
+	if x != nil {
+		return x.GangId
+	}
+	return ""
+}
+
+type LeaseAvailablePayload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LeaseRequestId string   `protobuf:"bytes,1,opt,name=leaseRequestId,proto3" json:"leaseRequestId,omitempty"`
+	GangId         string   `protobuf:"bytes,2,opt,name=gangId,proto3" json:"gangId,omitempty"`
+	VolumeIds      []string `protobuf:"bytes,3,rep,name=volumeIds,proto3" json:"volumeIds,omitempty"`
 }
 
-func (x *VolumeID) Reset() {
-	*x = VolumeID{}
+func (x *LeaseAvailablePayload) Reset() {
+	*x = LeaseAvailablePayload{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_svc_volchestrator_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -813,13 +1279,13 @@ func (x *VolumeID) Reset() {
 	}
 }
 
-func (x *VolumeID) String() string {
+func (x *LeaseAvailablePayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VolumeID) ProtoMessage() {}
+func (*LeaseAvailablePayload) ProtoMessage() {}
 
-func (x *VolumeID) ProtoReflect() protoreflect.Message {
+func (x *LeaseAvailablePayload) ProtoReflect() protoreflect.Message {
 	mi := &file_svc_volchestrator_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -831,31 +1297,44 @@ func (x *VolumeID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VolumeID.ProtoReflect.Descriptor instead.
-func (*VolumeID) Descriptor() ([]byte, []int) {
+// Deprecated: Use LeaseAvailablePayload.ProtoReflect.Descriptor instead.
+func (*LeaseAvailablePayload) Descriptor() ([]byte, []int) {
 	return file_svc_volchestrator_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *VolumeID) GetId() string {
+func (x *LeaseAvailablePayload) GetLeaseRequestId() string {
 	if x != nil {
-		return x.Id
+		return x.LeaseRequestId
 	}
 	return ""
 }
 
-type Volume struct {
+func (x *LeaseAvailablePayload) GetGangId() string {
+	if x != nil {
+		return x.GangId
+	}
+	return ""
+}
+
+func (x *LeaseAvailablePayload) GetVolumeIds() []string {
+	if x != nil {
+		return x.VolumeIds
+	}
+	return nil
+}
+
+type LeaseGrantedPayload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id               string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Tags             []string     `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
-	AvailabilityZone string       `protobuf:"bytes,3,opt,name=availabilityZone,proto3" json:"availabilityZone,omitempty"`
-	Status           VolumeStatus `protobuf:"varint,4,opt,name=status,proto3,enum=volchestrator.VolumeStatus" json:"status,omitempty"`
+	Lease      *Lease   `protobuf:"bytes,1,opt,name=lease,proto3" json:"lease,omitempty"`
+	GangId     string   `protobuf:"bytes,2,opt,name=gangId,proto3" json:"gangId,omitempty"`
+	GangLeases []*Lease `protobuf:"bytes,3,rep,name=gangLeases,proto3" json:"gangLeases,omitempty"`
 }
 
-func (x *Volume) Reset() {
-	*x = Volume{}
+func (x *LeaseGrantedPayload) Reset() {
+	*x = LeaseGrantedPayload{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_svc_volchestrator_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -863,13 +1342,13 @@ func (x *Volume) Reset() {
 	}
 }
 
-func (x *Volume) String() string {
+func (x *LeaseGrantedPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Volume) ProtoMessage() {}
+func (*LeaseGrantedPayload) ProtoMessage() {}
 
-func (x *Volume) ProtoReflect() protoreflect.Message {
+func (x *LeaseGrantedPayload) ProtoReflect() protoreflect.Message {
 	mi := &file_svc_volchestrator_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -881,49 +1360,43 @@ func (x *Volume) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Volume.ProtoReflect.Descriptor instead.
-func (*Volume) Descriptor() ([]byte, []int) {
+// Deprecated: Use LeaseGrantedPayload.ProtoReflect.Descriptor instead.
+func (*LeaseGrantedPayload) Descriptor() ([]byte, []int) {
 	return file_svc_volchestrator_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Volume) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Volume) GetTags() []string {
+func (x *LeaseGrantedPayload) GetLease() *Lease {
 	if x != nil {
-		return x.Tags
+		return x.Lease
 	}
 	return nil
 }
 
-func (x *Volume) GetAvailabilityZone() string {
+func (x *LeaseGrantedPayload) GetGangId() string {
 	if x != nil {
-		return x.AvailabilityZone
+		return x.GangId
 	}
 	return ""
 }
 
-func (x *Volume) GetStatus() VolumeStatus {
+func (x *LeaseGrantedPayload) GetGangLeases() []*Lease {
 	if x != nil {
-		return x.Status
+		return x.GangLeases
 	}
-	return VolumeStatus_VOLUMEUNKNOWN
+	return nil
 }
 
-type VolumeList struct {
+type LeaseRevokedPayload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Volumes []*Volume `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	LeaseId     string               `protobuf:"bytes,1,opt,name=leaseId,proto3" json:"leaseId,omitempty"`
+	GracePeriod *durationpb.Duration `protobuf:"bytes,2,opt,name=gracePeriod,proto3" json:"gracePeriod,omitempty"`
 }
 
-func (x *VolumeList) Reset() {
-	*x = VolumeList{}
+func (x *LeaseRevokedPayload) Reset() {
+	*x = LeaseRevokedPayload{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_svc_volchestrator_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -931,13 +1404,13 @@ func (x *VolumeList) Reset() {
 	}
 }
 
-func (x *VolumeList) String() string {
+func (x *LeaseRevokedPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VolumeList) ProtoMessage() {}
+func (*LeaseRevokedPayload) ProtoMessage() {}
 
-func (x *VolumeList) ProtoReflect() protoreflect.Message {
+func (x *LeaseRevokedPayload) ProtoReflect() protoreflect.Message {
 	mi := &file_svc_volchestrator_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -949,32 +1422,36 @@ func (x *VolumeList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VolumeList.ProtoReflect.Descriptor instead.
-func (*VolumeList) Descriptor() ([]byte, []int) {
+// Deprecated: Use LeaseRevokedPayload.ProtoReflect.Descriptor instead.
+func (*LeaseRevokedPayload) Descriptor() ([]byte, []int) {
 	return file_svc_volchestrator_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *VolumeList) GetVolumes() []*Volume {
+func (x *LeaseRevokedPayload) GetLeaseId() string {
 	if x != nil {
-		return x.Volumes
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *LeaseRevokedPayload) GetGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.GracePeriod
 	}
 	return nil
 }
 
-type Lease struct {
+type LeaseReleasedPayload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LeaseId  string                 `protobuf:"bytes,1,opt,name=leaseId,proto3" json:"leaseId,omitempty"`
-	ClientId string                 `protobuf:"bytes,2,opt,name=clientId,proto3" json:"clientId,omitempty"`
-	VolumeId string                 `protobuf:"bytes,3,opt,name=volumeId,proto3" json:"volumeId,omitempty"`
-	Expires  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires,proto3" json:"expires,omitempty"`
-	Status   LeaseStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=volchestrator.LeaseStatus" json:"status,omitempty"`
+	LeaseId string `protobuf:"bytes,1,opt,name=leaseId,proto3" json:"leaseId,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *Lease) Reset() {
-	*x = Lease{}
+func (x *LeaseReleasedPayload) Reset() {
+	*x = LeaseReleasedPayload{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_svc_volchestrator_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -982,13 +1459,13 @@ func (x *Lease) Reset() {
 	}
 }
 
-func (x *Lease) String() string {
+func (x *LeaseReleasedPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Lease) ProtoMessage() {}
+func (*LeaseReleasedPayload) ProtoMessage() {}
 
-func (x *Lease) ProtoReflect() protoreflect.Message {
+func (x *LeaseReleasedPayload) ProtoReflect() protoreflect.Message {
 	mi := &file_svc_volchestrator_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1000,56 +1477,36 @@ func (x *Lease) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Lease.ProtoReflect.Descriptor instead.
-func (*Lease) Descriptor() ([]byte, []int) {
+// Deprecated: Use LeaseReleasedPayload.ProtoReflect.Descriptor instead.
+func (*LeaseReleasedPayload) Descriptor() ([]byte, []int) {
 	return file_svc_volchestrator_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Lease) GetLeaseId() string {
+func (x *LeaseReleasedPayload) GetLeaseId() string {
 	if x != nil {
 		return x.LeaseId
 	}
 	return ""
 }
 
-func (x *Lease) GetClientId() string {
-	if x != nil {
-		return x.ClientId
-	}
-	return ""
-}
-
-func (x *Lease) GetVolumeId() string {
+func (x *LeaseReleasedPayload) GetReason() string {
 	if x != nil {
-		return x.VolumeId
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *Lease) GetExpires() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Expires
-	}
-	return nil
-}
-
-func (x *Lease) GetStatus() LeaseStatus {
-	if x != nil {
-		return x.Status
-	}
-	return LeaseStatus_LEASEUNKNOWN
-}
-
-type LeaseList struct {
+type LeaseTransferredPayload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Leases []*Lease `protobuf:"bytes,1,rep,name=leases,proto3" json:"leases,omitempty"`
+	LeaseId        string `protobuf:"bytes,1,opt,name=leaseId,proto3" json:"leaseId,omitempty"`
+	TargetClientId string `protobuf:"bytes,2,opt,name=targetClientId,proto3" json:"targetClientId,omitempty"`
 }
 
-func (x *LeaseList) Reset() {
-	*x = LeaseList{}
+func (x *LeaseTransferredPayload) Reset() {
+	*x = LeaseTransferredPayload{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_svc_volchestrator_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1057,13 +1514,13 @@ func (x *LeaseList) Reset() {
 	}
 }
 
-func (x *LeaseList) String() string {
+func (x *LeaseTransferredPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaseList) ProtoMessage() {}
+func (*LeaseTransferredPayload) ProtoMessage() {}
 
-func (x *LeaseList) ProtoReflect() protoreflect.Message {
+func (x *LeaseTransferredPayload) ProtoReflect() protoreflect.Message {
 	mi := &file_svc_volchestrator_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1075,24 +1532,1986 @@ func (x *LeaseList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaseList.ProtoReflect.Descriptor instead.
-func (*LeaseList) Descriptor() ([]byte, []int) {
+// Deprecated: Use LeaseTransferredPayload.ProtoReflect.Descriptor instead.
+func (*LeaseTransferredPayload) Descriptor() ([]byte, []int) {
 	return file_svc_volchestrator_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *LeaseList) GetLeases() []*Lease {
+func (x *LeaseTransferredPayload) GetLeaseId() string {
 	if x != nil {
-		return x.Leases
+		return x.LeaseId
 	}
-	return nil
+	return ""
 }
 
-var File_svc_volchestrator_proto protoreflect.FileDescriptor
-
-var file_svc_volchestrator_proto_rawDesc = []byte{
+func (x *LeaseTransferredPayload) GetTargetClientId() string {
+	if x != nil {
+		return x.TargetClientId
+	}
+	return ""
+}
+
+type LeaseLifetimeWarningPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseId  string                 `protobuf:"bytes,1,opt,name=leaseId,proto3" json:"leaseId,omitempty"`
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (x *LeaseLifetimeWarningPayload) Reset() {
+	*x = LeaseLifetimeWarningPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseLifetimeWarningPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseLifetimeWarningPayload) ProtoMessage() {}
+
+func (x *LeaseLifetimeWarningPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseLifetimeWarningPayload.ProtoReflect.Descriptor instead.
+func (*LeaseLifetimeWarningPayload) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LeaseLifetimeWarningPayload) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *LeaseLifetimeWarningPayload) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+type LeaseExpiringPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseId        string                 `protobuf:"bytes,1,opt,name=leaseId,proto3" json:"leaseId,omitempty"`
+	LeaseRequestId string                 `protobuf:"bytes,2,opt,name=leaseRequestId,proto3" json:"leaseRequestId,omitempty"`
+	GangId         string                 `protobuf:"bytes,3,opt,name=gangId,proto3" json:"gangId,omitempty"`
+	Expires        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires,proto3" json:"expires,omitempty"`
+}
+
+func (x *LeaseExpiringPayload) Reset() {
+	*x = LeaseExpiringPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseExpiringPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseExpiringPayload) ProtoMessage() {}
+
+func (x *LeaseExpiringPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseExpiringPayload.ProtoReflect.Descriptor instead.
+func (*LeaseExpiringPayload) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LeaseExpiringPayload) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *LeaseExpiringPayload) GetLeaseRequestId() string {
+	if x != nil {
+		return x.LeaseRequestId
+	}
+	return ""
+}
+
+func (x *LeaseExpiringPayload) GetGangId() string {
+	if x != nil {
+		return x.GangId
+	}
+	return ""
+}
+
+func (x *LeaseExpiringPayload) GetExpires() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expires
+	}
+	return nil
+}
+
+type Acknowledgement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *Acknowledgement) Reset() {
+	*x = Acknowledgement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Acknowledgement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Acknowledgement) ProtoMessage() {}
+
+func (x *Acknowledgement) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Acknowledgement.ProtoReflect.Descriptor instead.
+func (*Acknowledgement) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Acknowledgement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ReleaseLeaseMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId string `protobuf:"bytes,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	LeaseId  string `protobuf:"bytes,2,opt,name=leaseId,proto3" json:"leaseId,omitempty"`
+}
+
+func (x *ReleaseLeaseMessage) Reset() {
+	*x = ReleaseLeaseMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseLeaseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseLeaseMessage) ProtoMessage() {}
+
+func (x *ReleaseLeaseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseLeaseMessage.ProtoReflect.Descriptor instead.
+func (*ReleaseLeaseMessage) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReleaseLeaseMessage) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ReleaseLeaseMessage) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+type ListClientLeasesMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId string `protobuf:"bytes,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+}
+
+func (x *ListClientLeasesMessage) Reset() {
+	*x = ListClientLeasesMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListClientLeasesMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientLeasesMessage) ProtoMessage() {}
+
+func (x *ListClientLeasesMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientLeasesMessage.ProtoReflect.Descriptor instead.
+func (*ListClientLeasesMessage) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListClientLeasesMessage) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type CancelLeaseRequestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId       string `protobuf:"bytes,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	LeaseRequestId string `protobuf:"bytes,2,opt,name=leaseRequestId,proto3" json:"leaseRequestId,omitempty"`
+}
+
+func (x *CancelLeaseRequestMessage) Reset() {
+	*x = CancelLeaseRequestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelLeaseRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelLeaseRequestMessage) ProtoMessage() {}
+
+func (x *CancelLeaseRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelLeaseRequestMessage.ProtoReflect.Descriptor instead.
+func (*CancelLeaseRequestMessage) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CancelLeaseRequestMessage) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *CancelLeaseRequestMessage) GetLeaseRequestId() string {
+	if x != nil {
+		return x.LeaseRequestId
+	}
+	return ""
+}
+
+type UpdateLeaseRequestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId         string `protobuf:"bytes,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	LeaseRequestId   string `protobuf:"bytes,2,opt,name=leaseRequestId,proto3" json:"leaseRequestId,omitempty"`
+	Tag              string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	AvailabilityZone string `protobuf:"bytes,4,opt,name=availabilityZone,proto3" json:"availabilityZone,omitempty"`
+	VolumeId         string `protobuf:"bytes,5,opt,name=volumeId,proto3" json:"volumeId,omitempty"`
+	Priority         int32  `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (x *UpdateLeaseRequestMessage) Reset() {
+	*x = UpdateLeaseRequestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateLeaseRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLeaseRequestMessage) ProtoMessage() {}
+
+func (x *UpdateLeaseRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLeaseRequestMessage.ProtoReflect.Descriptor instead.
+func (*UpdateLeaseRequestMessage) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateLeaseRequestMessage) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *UpdateLeaseRequestMessage) GetLeaseRequestId() string {
+	if x != nil {
+		return x.LeaseRequestId
+	}
+	return ""
+}
+
+func (x *UpdateLeaseRequestMessage) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *UpdateLeaseRequestMessage) GetAvailabilityZone() string {
+	if x != nil {
+		return x.AvailabilityZone
+	}
+	return ""
+}
+
+func (x *UpdateLeaseRequestMessage) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *UpdateLeaseRequestMessage) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type TransferLeaseMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId       string `protobuf:"bytes,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	LeaseId        string `protobuf:"bytes,2,opt,name=leaseId,proto3" json:"leaseId,omitempty"`
+	TargetClientId string `protobuf:"bytes,3,opt,name=targetClientId,proto3" json:"targetClientId,omitempty"`
+}
+
+func (x *TransferLeaseMessage) Reset() {
+	*x = TransferLeaseMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferLeaseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferLeaseMessage) ProtoMessage() {}
+
+func (x *TransferLeaseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferLeaseMessage.ProtoReflect.Descriptor instead.
+func (*TransferLeaseMessage) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TransferLeaseMessage) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *TransferLeaseMessage) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *TransferLeaseMessage) GetTargetClientId() string {
+	if x != nil {
+		return x.TargetClientId
+	}
+	return ""
+}
+
+type RenewLeaseMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId string `protobuf:"bytes,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	Id       string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RenewLeaseMessage) Reset() {
+	*x = RenewLeaseMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewLeaseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewLeaseMessage) ProtoMessage() {}
+
+func (x *RenewLeaseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewLeaseMessage.ProtoReflect.Descriptor instead.
+func (*RenewLeaseMessage) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RenewLeaseMessage) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *RenewLeaseMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ValidateLeaseMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseId      string `protobuf:"bytes,1,opt,name=leaseId,proto3" json:"leaseId,omitempty"`
+	FencingToken uint64 `protobuf:"varint,2,opt,name=fencingToken,proto3" json:"fencingToken,omitempty"`
+}
+
+func (x *ValidateLeaseMessage) Reset() {
+	*x = ValidateLeaseMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateLeaseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateLeaseMessage) ProtoMessage() {}
+
+func (x *ValidateLeaseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateLeaseMessage.ProtoReflect.Descriptor instead.
+func (*ValidateLeaseMessage) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ValidateLeaseMessage) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *ValidateLeaseMessage) GetFencingToken() uint64 {
+	if x != nil {
+		return x.FencingToken
+	}
+	return 0
+}
+
+type ValidateLeaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid  bool   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ValidateLeaseResponse) Reset() {
+	*x = ValidateLeaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateLeaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateLeaseResponse) ProtoMessage() {}
+
+func (x *ValidateLeaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateLeaseResponse.ProtoReflect.Descriptor instead.
+func (*ValidateLeaseResponse) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ValidateLeaseResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateLeaseResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RenewLeaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ttl     *durationpb.Duration   `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Expires *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires,proto3" json:"expires,omitempty"`
+}
+
+func (x *RenewLeaseResponse) Reset() {
+	*x = RenewLeaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewLeaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewLeaseResponse) ProtoMessage() {}
+
+func (x *RenewLeaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewLeaseResponse.ProtoReflect.Descriptor instead.
+func (*RenewLeaseResponse) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RenewLeaseResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RenewLeaseResponse) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *RenewLeaseResponse) GetExpires() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expires
+	}
+	return nil
+}
+
+type ClientInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClientStatus ClientStatus           `protobuf:"varint,2,opt,name=clientStatus,proto3,enum=volchestrator.ClientStatus" json:"clientStatus,omitempty"`
+	FirstSeen    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=firstSeen,proto3" json:"firstSeen,omitempty"`
+	LastSeen     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=lastSeen,proto3" json:"lastSeen,omitempty"`
+}
+
+func (x *ClientInfo) Reset() {
+	*x = ClientInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientInfo) ProtoMessage() {}
+
+func (x *ClientInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientInfo.ProtoReflect.Descriptor instead.
+func (*ClientInfo) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ClientInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClientInfo) GetClientStatus() ClientStatus {
+	if x != nil {
+		return x.ClientStatus
+	}
+	return ClientStatus_CLIENTUNKNOWN
+}
+
+func (x *ClientInfo) GetFirstSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return nil
+}
+
+func (x *ClientInfo) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+type ClientList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Info []*ClientInfo `protobuf:"bytes,1,rep,name=info,proto3" json:"info,omitempty"`
+}
+
+func (x *ClientList) Reset() {
+	*x = ClientList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientList) ProtoMessage() {}
+
+func (x *ClientList) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientList.ProtoReflect.Descriptor instead.
+func (*ClientList) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ClientList) GetInfo() []*ClientInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{30}
+}
+
+type VolumeID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *VolumeID) Reset() {
+	*x = VolumeID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeID) ProtoMessage() {}
+
+func (x *VolumeID) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeID.ProtoReflect.Descriptor instead.
+func (*VolumeID) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *VolumeID) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Volume struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tags             []string     `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	AvailabilityZone string       `protobuf:"bytes,3,opt,name=availabilityZone,proto3" json:"availabilityZone,omitempty"`
+	Status           VolumeStatus `protobuf:"varint,4,opt,name=status,proto3,enum=volchestrator.VolumeStatus" json:"status,omitempty"`
+	FencingToken     uint64       `protobuf:"varint,5,opt,name=fencingToken,proto3" json:"fencingToken,omitempty"`
+	MultiAttach      bool         `protobuf:"varint,6,opt,name=multiAttach,proto3" json:"multiAttach,omitempty"`
+	MaxReaders       int32        `protobuf:"varint,7,opt,name=maxReaders,proto3" json:"maxReaders,omitempty"`
+}
+
+func (x *Volume) Reset() {
+	*x = Volume{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Volume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Volume) ProtoMessage() {}
+
+func (x *Volume) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Volume.ProtoReflect.Descriptor instead.
+func (*Volume) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Volume) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Volume) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Volume) GetAvailabilityZone() string {
+	if x != nil {
+		return x.AvailabilityZone
+	}
+	return ""
+}
+
+func (x *Volume) GetStatus() VolumeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VolumeStatus_VOLUMEUNKNOWN
+}
+
+func (x *Volume) GetFencingToken() uint64 {
+	if x != nil {
+		return x.FencingToken
+	}
+	return 0
+}
+
+func (x *Volume) GetMultiAttach() bool {
+	if x != nil {
+		return x.MultiAttach
+	}
+	return false
+}
+
+func (x *Volume) GetMaxReaders() int32 {
+	if x != nil {
+		return x.MaxReaders
+	}
+	return 0
+}
+
+type VolumeList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Volumes []*Volume `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
+}
+
+func (x *VolumeList) Reset() {
+	*x = VolumeList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeList) ProtoMessage() {}
+
+func (x *VolumeList) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeList.ProtoReflect.Descriptor instead.
+func (*VolumeList) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *VolumeList) GetVolumes() []*Volume {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+type Lease struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseId        string                 `protobuf:"bytes,1,opt,name=leaseId,proto3" json:"leaseId,omitempty"`
+	ClientId       string                 `protobuf:"bytes,2,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	VolumeId       string                 `protobuf:"bytes,3,opt,name=volumeId,proto3" json:"volumeId,omitempty"`
+	Expires        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires,proto3" json:"expires,omitempty"`
+	Status         LeaseStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=volchestrator.LeaseStatus" json:"status,omitempty"`
+	GangId         string                 `protobuf:"bytes,6,opt,name=gangId,proto3" json:"gangId,omitempty"`
+	Priority       int32                  `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	Ttl            *durationpb.Duration   `protobuf:"bytes,8,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	FencingToken   uint64                 `protobuf:"varint,9,opt,name=fencingToken,proto3" json:"fencingToken,omitempty"`
+	AccessMode     LeaseAccessMode        `protobuf:"varint,10,opt,name=accessMode,proto3,enum=volchestrator.LeaseAccessMode" json:"accessMode,omitempty"`
+	Acquired       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=acquired,proto3" json:"acquired,omitempty"`
+	Deadline       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	LeaseRequestId string                 `protobuf:"bytes,13,opt,name=leaseRequestId,proto3" json:"leaseRequestId,omitempty"`
+}
+
+func (x *Lease) Reset() {
+	*x = Lease{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Lease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lease) ProtoMessage() {}
+
+func (x *Lease) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lease.ProtoReflect.Descriptor instead.
+func (*Lease) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Lease) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *Lease) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *Lease) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *Lease) GetExpires() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expires
+	}
+	return nil
+}
+
+func (x *Lease) GetStatus() LeaseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LeaseStatus_LEASEUNKNOWN
+}
+
+func (x *Lease) GetGangId() string {
+	if x != nil {
+		return x.GangId
+	}
+	return ""
+}
+
+func (x *Lease) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Lease) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *Lease) GetFencingToken() uint64 {
+	if x != nil {
+		return x.FencingToken
+	}
+	return 0
+}
+
+func (x *Lease) GetAccessMode() LeaseAccessMode {
+	if x != nil {
+		return x.AccessMode
+	}
+	return LeaseAccessMode_LEASEACCESSEXCLUSIVE
+}
+
+func (x *Lease) GetAcquired() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Acquired
+	}
+	return nil
+}
+
+func (x *Lease) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *Lease) GetLeaseRequestId() string {
+	if x != nil {
+		return x.LeaseRequestId
+	}
+	return ""
+}
+
+type LeaseList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Leases []*Lease `protobuf:"bytes,1,rep,name=leases,proto3" json:"leases,omitempty"`
+}
+
+func (x *LeaseList) Reset() {
+	*x = LeaseList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseList) ProtoMessage() {}
+
+func (x *LeaseList) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseList.ProtoReflect.Descriptor instead.
+func (*LeaseList) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *LeaseList) GetLeases() []*Lease {
+	if x != nil {
+		return x.Leases
+	}
+	return nil
+}
+
+type LeaseRequestID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *LeaseRequestID) Reset() {
+	*x = LeaseRequestID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseRequestID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseRequestID) ProtoMessage() {}
+
+func (x *LeaseRequestID) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseRequestID.ProtoReflect.Descriptor instead.
+func (*LeaseRequestID) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LeaseRequestID) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type VolumeExplanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VolumeId string   `protobuf:"bytes,1,opt,name=volumeId,proto3" json:"volumeId,omitempty"`
+	Eligible bool     `protobuf:"varint,2,opt,name=eligible,proto3" json:"eligible,omitempty"`
+	Reasons  []string `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"`
+}
+
+func (x *VolumeExplanation) Reset() {
+	*x = VolumeExplanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeExplanation) ProtoMessage() {}
+
+func (x *VolumeExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeExplanation.ProtoReflect.Descriptor instead.
+func (*VolumeExplanation) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *VolumeExplanation) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *VolumeExplanation) GetEligible() bool {
+	if x != nil {
+		return x.Eligible
+	}
+	return false
+}
+
+func (x *VolumeExplanation) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+type LeaseRequestExplanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseRequestId string                     `protobuf:"bytes,1,opt,name=leaseRequestId,proto3" json:"leaseRequestId,omitempty"`
+	Volumes        []*VolumeExplanation       `protobuf:"bytes,2,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	GangId         string                     `protobuf:"bytes,3,opt,name=gangId,proto3" json:"gangId,omitempty"`
+	Members        []*LeaseRequestExplanation `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *LeaseRequestExplanation) Reset() {
+	*x = LeaseRequestExplanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseRequestExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseRequestExplanation) ProtoMessage() {}
+
+func (x *LeaseRequestExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseRequestExplanation.ProtoReflect.Descriptor instead.
+func (*LeaseRequestExplanation) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *LeaseRequestExplanation) GetLeaseRequestId() string {
+	if x != nil {
+		return x.LeaseRequestId
+	}
+	return ""
+}
+
+func (x *LeaseRequestExplanation) GetVolumes() []*VolumeExplanation {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *LeaseRequestExplanation) GetGangId() string {
+	if x != nil {
+		return x.GangId
+	}
+	return ""
+}
+
+func (x *LeaseRequestExplanation) GetMembers() []*LeaseRequestExplanation {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type HistoryEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	LeaseRequestId string                 `protobuf:"bytes,2,opt,name=leaseRequestId,proto3" json:"leaseRequestId,omitempty"`
+	LeaseId        string                 `protobuf:"bytes,3,opt,name=leaseId,proto3" json:"leaseId,omitempty"`
+	ClientId       string                 `protobuf:"bytes,4,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	VolumeId       string                 `protobuf:"bytes,5,opt,name=volumeId,proto3" json:"volumeId,omitempty"`
+	State          HistoryState           `protobuf:"varint,6,opt,name=state,proto3,enum=volchestrator.HistoryState" json:"state,omitempty"`
+	Reason         string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *HistoryEvent) Reset() {
+	*x = HistoryEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoryEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryEvent) ProtoMessage() {}
+
+func (x *HistoryEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryEvent.ProtoReflect.Descriptor instead.
+func (*HistoryEvent) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *HistoryEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *HistoryEvent) GetLeaseRequestId() string {
+	if x != nil {
+		return x.LeaseRequestId
+	}
+	return ""
+}
+
+func (x *HistoryEvent) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *HistoryEvent) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *HistoryEvent) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *HistoryEvent) GetState() HistoryState {
+	if x != nil {
+		return x.State
+	}
+	return HistoryState_HISTORYUNKNOWN
+}
+
+func (x *HistoryEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type HistoryEventList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*HistoryEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *HistoryEventList) Reset() {
+	*x = HistoryEventList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoryEventList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryEventList) ProtoMessage() {}
+
+func (x *HistoryEventList) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryEventList.ProtoReflect.Descriptor instead.
+func (*HistoryEventList) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *HistoryEventList) GetEvents() []*HistoryEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type HistoryQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VolumeId string                 `protobuf:"bytes,1,opt,name=volumeId,proto3" json:"volumeId,omitempty"`
+	ClientId string                 `protobuf:"bytes,2,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	LeaseId  string                 `protobuf:"bytes,3,opt,name=leaseId,proto3" json:"leaseId,omitempty"`
+	Since    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`
+	Until    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=until,proto3" json:"until,omitempty"`
+}
+
+func (x *HistoryQuery) Reset() {
+	*x = HistoryQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoryQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryQuery) ProtoMessage() {}
+
+func (x *HistoryQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryQuery.ProtoReflect.Descriptor instead.
+func (*HistoryQuery) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *HistoryQuery) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *HistoryQuery) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *HistoryQuery) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *HistoryQuery) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *HistoryQuery) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type NotificationStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId       string `protobuf:"bytes,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	Pending        int64  `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	Written        uint64 `protobuf:"varint,3,opt,name=written,proto3" json:"written,omitempty"`
+	Delivered      uint64 `protobuf:"varint,4,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	Redelivered    uint64 `protobuf:"varint,5,opt,name=redelivered,proto3" json:"redelivered,omitempty"`
+	Dropped        uint64 `protobuf:"varint,6,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	Coalesced      uint64 `protobuf:"varint,7,opt,name=coalesced,proto3" json:"coalesced,omitempty"`
+	StreamsDropped uint64 `protobuf:"varint,8,opt,name=streamsDropped,proto3" json:"streamsDropped,omitempty"`
+}
+
+func (x *NotificationStats) Reset() {
+	*x = NotificationStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationStats) ProtoMessage() {}
+
+func (x *NotificationStats) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationStats.ProtoReflect.Descriptor instead.
+func (*NotificationStats) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *NotificationStats) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *NotificationStats) GetPending() int64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *NotificationStats) GetWritten() uint64 {
+	if x != nil {
+		return x.Written
+	}
+	return 0
+}
+
+func (x *NotificationStats) GetDelivered() uint64 {
+	if x != nil {
+		return x.Delivered
+	}
+	return 0
+}
+
+func (x *NotificationStats) GetRedelivered() uint64 {
+	if x != nil {
+		return x.Redelivered
+	}
+	return 0
+}
+
+func (x *NotificationStats) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+func (x *NotificationStats) GetCoalesced() uint64 {
+	if x != nil {
+		return x.Coalesced
+	}
+	return 0
+}
+
+func (x *NotificationStats) GetStreamsDropped() uint64 {
+	if x != nil {
+		return x.StreamsDropped
+	}
+	return 0
+}
+
+type NotificationStatsList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats []*NotificationStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *NotificationStatsList) Reset() {
+	*x = NotificationStatsList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationStatsList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationStatsList) ProtoMessage() {}
+
+func (x *NotificationStatsList) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationStatsList.ProtoReflect.Descriptor instead.
+func (*NotificationStatsList) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *NotificationStatsList) GetStats() []*NotificationStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Type           EventType              `protobuf:"varint,2,opt,name=type,proto3,enum=volchestrator.EventType" json:"type,omitempty"`
+	ClientId       string                 `protobuf:"bytes,3,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	VolumeId       string                 `protobuf:"bytes,4,opt,name=volumeId,proto3" json:"volumeId,omitempty"`
+	LeaseRequestId string                 `protobuf:"bytes,5,opt,name=leaseRequestId,proto3" json:"leaseRequestId,omitempty"`
+	LeaseId        string                 `protobuf:"bytes,6,opt,name=leaseId,proto3" json:"leaseId,omitempty"`
+	Message        string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Event) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *Event) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_EVENTUNKNOWN
+}
+
+func (x *Event) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *Event) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *Event) GetLeaseRequestId() string {
+	if x != nil {
+		return x.LeaseRequestId
+	}
+	return ""
+}
+
+func (x *Event) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *Event) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type EventFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Types    []EventType `protobuf:"varint,1,rep,packed,name=types,proto3,enum=volchestrator.EventType" json:"types,omitempty"`
+	ClientId string      `protobuf:"bytes,2,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	VolumeId string      `protobuf:"bytes,3,opt,name=volumeId,proto3" json:"volumeId,omitempty"`
+}
+
+func (x *EventFilter) Reset() {
+	*x = EventFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_svc_volchestrator_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventFilter) ProtoMessage() {}
+
+func (x *EventFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_svc_volchestrator_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventFilter.ProtoReflect.Descriptor instead.
+func (*EventFilter) Descriptor() ([]byte, []int) {
+	return file_svc_volchestrator_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *EventFilter) GetTypes() []EventType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *EventFilter) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *EventFilter) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+var File_svc_volchestrator_proto protoreflect.FileDescriptor
+
+var file_svc_volchestrator_proto_rawDesc = []byte{
 	0x0a, 0x17, 0x73, 0x76, 0x63, 0x2f, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61,
 	0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x76, 0x6f, 0x6c, 0x63, 0x68,
-	0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
 	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x21, 0x0a, 0x0f, 0x52, 0x65, 0x67,
 	0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02,
@@ -1103,164 +3522,631 @@ var file_svc_volchestrator_proto_rawDesc = []byte{
 	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x11, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
 	0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x68, 0x0a, 0x0c, 0x4c, 0x65,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x2a, 0x0a, 0x18, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x6d, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xb5, 0x02, 0x0a, 0x0c, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2b, 0x0a,
+	0x03, 0x74, 0x74, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x3e, 0x0a, 0x0a, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e,
+	0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64,
+	0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b,
+	0x65, 0x79, 0x22, 0xbc, 0x01, 0x0a, 0x10, 0x47, 0x61, 0x6e, 0x67, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x03,
+	0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
+	0x79, 0x22, 0x4c, 0x0a, 0x18, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22,
+	0xc7, 0x07, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
 	0x12, 0x33, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f,
 	0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4e,
 	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x21, 0x0a, 0x0f, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0xcf, 0x01, 0x0a, 0x0a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x3f, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65,
-	0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x36, 0x0a, 0x08,
-	0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74,
-	0x53, 0x65, 0x65, 0x6e, 0x22, 0x3b, 0x0a, 0x0a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66,
-	0x6f, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1a, 0x0a, 0x08, 0x56, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8d, 0x01, 0x0a, 0x06, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
-	0x69, 0x6c, 0x69, 0x74, 0x79, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5a, 0x6f, 0x6e,
-	0x65, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1b, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3d, 0x0a, 0x0a, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x07, 0x76, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x73, 0x22, 0xc3, 0x01, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x54, 0x0a, 0x10, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x76, 0x6f,
+	0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x51, 0x0a, 0x0f, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x6b,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x0f, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x6b, 0x12, 0x5d, 0x0a, 0x13, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x48, 0x00, 0x52, 0x13, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x0e, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x48, 0x0a, 0x0c, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x0c, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x6f, 0x6c, 0x63,
+	0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52,
+	0x0c, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12, 0x4b, 0x0a,
+	0x0d, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x54, 0x0a, 0x10, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x72, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x10,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x12, 0x60, 0x0a, 0x14, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d,
+	0x65, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x14, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x12, 0x4b, 0x0a, 0x0d, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x76, 0x6f, 0x6c, 0x63,
+	0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00,
+	0x52, 0x0d, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x42,
+	0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x35, 0x0a, 0x17, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0x58, 0x0a, 0x16, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x41, 0x63, 0x6b, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x61, 0x6e, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x5c, 0x0a, 0x1a, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x67, 0x61, 0x6e, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x67, 0x61, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x75, 0x0a, 0x15, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x61, 0x6e,
+	0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6e, 0x67, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x73, 0x22,
+	0x8f, 0x01, 0x0a, 0x13, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x05, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x61, 0x6e, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x67,
+	0x61, 0x6e, 0x67, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x0a, 0x67, 0x61, 0x6e, 0x67, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x73, 0x22, 0x6c, 0x0a, 0x13, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22,
+	0x48, 0x0a, 0x14, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x5b, 0x0a, 0x17, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x26,
+	0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x6f, 0x0a, 0x1b, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x4c,
+	0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12,
+	0x36, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x14, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x61, 0x6e, 0x67, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x22, 0x21, 0x0a, 0x0f, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x4b, 0x0a, 0x13, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64,
+	0x22, 0x35, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x19, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0xd5, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x2a, 0x0a, 0x10,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5a, 0x6f, 0x6e, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x22, 0x74, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x26,
+	0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x3f, 0x0a, 0x11, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x54, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
 	0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49,
-	0x64, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65,
-	0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x39, 0x0a, 0x09, 0x4c,
-	0x65, 0x61, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x73,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68,
-	0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x06,
-	0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x2a, 0xa8, 0x01, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x4e,
-	0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
-	0x57, 0x4e, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
-	0x41, 0x43, 0x4b, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
-	0x54, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x4e, 0x4f,
-	0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x41,
-	0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x4e, 0x4f,
-	0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x10,
-	0x04, 0x2a, 0x52, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
-	0x57, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x41, 0x4c,
-	0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x44,
-	0x45, 0x41, 0x44, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x4c,
-	0x45, 0x46, 0x54, 0x10, 0x03, 0x2a, 0x60, 0x0a, 0x0c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x11, 0x0a, 0x0d, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x56, 0x4f, 0x4c, 0x55,
-	0x4d, 0x45, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x16, 0x0a,
-	0x12, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x50, 0x45, 0x4e, 0x44,
-	0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x4c,
-	0x45, 0x41, 0x53, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x5a, 0x0a, 0x0b, 0x4c, 0x65, 0x61, 0x73, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x45, 0x41, 0x53,
-	0x45, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d,
-	0x4c, 0x45, 0x41, 0x53, 0x45, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x12,
-	0x12, 0x0a, 0x0e, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x49, 0x4e,
-	0x47, 0x10, 0x03, 0x32, 0xdf, 0x03, 0x0a, 0x0d, 0x56, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x42, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x12, 0x1e, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x1a, 0x14, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0a, 0x44, 0x65, 0x72,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65,
-	0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x14, 0x2e, 0x76, 0x6f, 0x6c, 0x63,
+	0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x65, 0x6e,
+	0x63, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x66, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x45, 0x0a,
+	0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x87, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x03, 0x74,
+	0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x22, 0xcf,
+	0x01, 0x0a, 0x0a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3f, 0x0a,
+	0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x38,
+	0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x36, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74,
+	0x53, 0x65, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e,
+	0x22, 0x3b, 0x0a, 0x0a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2d,
+	0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76,
+	0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x07, 0x0a,
+	0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1a, 0x0a, 0x08, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0xf3, 0x01, 0x0a, 0x06, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x33, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x65, 0x6e, 0x63, 0x69, 0x6e,
+	0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x52,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61,
+	0x78, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0x3d, 0x0a, 0x0a, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x07,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x22, 0xa0, 0x04, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x76, 0x6f, 0x6c, 0x63,
+	0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x67, 0x61, 0x6e, 0x67, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x61, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x22,
+	0x0a, 0x0c, 0x66, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x3e, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x6f, 0x64, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x08, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x09, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x06, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x73, 0x22, 0x20, 0x0a, 0x0e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x65, 0x0a, 0x11, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x69, 0x67,
+	0x69, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x6c, 0x69, 0x67,
+	0x69, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0xd7,
+	0x01, 0x0a, 0x17, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45,
+	0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x3a, 0x0a, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x67, 0x61, 0x6e, 0x67, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x67, 0x61, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x83, 0x02, 0x0a, 0x0c, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x47,
+	0x0a, 0x10, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xc4, 0x01, 0x0a, 0x0c, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x05,
+	0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x83,
+	0x02, 0x0a, 0x11, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x72,
+	0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x77, 0x72, 0x69,
+	0x74, 0x74, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x61, 0x6c, 0x65, 0x73, 0x63, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x63, 0x6f, 0x61, 0x6c, 0x65, 0x73, 0x63, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x44, 0x72, 0x6f,
+	0x70, 0x70, 0x65, 0x64, 0x22, 0x4f, 0x0a, 0x15, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x36, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76,
+	0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xf9, 0x01, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x75, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x2e, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x2a, 0xcc, 0x02, 0x0a, 0x10, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a,
+	0x13, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49,
+	0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x52, 0x45, 0x51, 0x55, 0x45,
+	0x53, 0x54, 0x41, 0x43, 0x4b, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x4e, 0x4f, 0x54, 0x49, 0x46,
+	0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a,
+	0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x4c, 0x45, 0x41, 0x53,
+	0x45, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11,
+	0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x4c, 0x45, 0x41, 0x53,
+	0x45, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10,
+	0x05, 0x12, 0x1d, 0x0a, 0x19, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x44, 0x10, 0x06,
+	0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x4c, 0x45, 0x41, 0x53, 0x45, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44,
+	0x10, 0x07, 0x12, 0x24, 0x0a, 0x20, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x4c, 0x49, 0x46, 0x45, 0x54, 0x49, 0x4d, 0x45, 0x57,
+	0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x08, 0x12, 0x1d, 0x0a, 0x19, 0x4e, 0x4f, 0x54, 0x49,
+	0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x45, 0x58, 0x50,
+	0x49, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x09, 0x2a, 0x42, 0x0a, 0x0f, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x4c, 0x45,
+	0x41, 0x53, 0x45, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x45, 0x58, 0x43, 0x4c, 0x55, 0x53, 0x49,
+	0x56, 0x45, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x41, 0x43, 0x43,
+	0x45, 0x53, 0x53, 0x53, 0x48, 0x41, 0x52, 0x45, 0x44, 0x10, 0x01, 0x2a, 0x67, 0x0a, 0x0c, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x11, 0x0a, 0x0d, 0x43,
+	0x4c, 0x49, 0x45, 0x4e, 0x54, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0f,
+	0x0a, 0x0b, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x41, 0x4c, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12,
+	0x0e, 0x0a, 0x0a, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x44, 0x45, 0x41, 0x44, 0x10, 0x02, 0x12,
+	0x0e, 0x0a, 0x0a, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x03, 0x12,
+	0x13, 0x0a, 0x0f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x55, 0x4e, 0x48, 0x45, 0x41, 0x4c, 0x54,
+	0x48, 0x59, 0x10, 0x04, 0x2a, 0x78, 0x0a, 0x0c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x11, 0x0a, 0x0d, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x56, 0x4f, 0x4c, 0x55, 0x4d,
+	0x45, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12,
+	0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x4c, 0x45,
+	0x41, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45,
+	0x53, 0x48, 0x41, 0x52, 0x45, 0x44, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x84,
+	0x01, 0x0a, 0x0b, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10,
+	0x0a, 0x0c, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
+	0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x41, 0x53, 0x53,
+	0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x45, 0x41, 0x53, 0x45,
+	0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x4c,
+	0x45, 0x41, 0x53, 0x45, 0x4f, 0x52, 0x50, 0x48, 0x41, 0x4e, 0x45, 0x44, 0x10, 0x04, 0x12, 0x15,
+	0x0a, 0x11, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x52,
+	0x49, 0x4e, 0x47, 0x10, 0x05, 0x2a, 0x85, 0x02, 0x0a, 0x0c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52,
+	0x59, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x48, 0x49,
+	0x53, 0x54, 0x4f, 0x52, 0x59, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x12, 0x0a, 0x0e, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x4f, 0x46, 0x46, 0x45, 0x52,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x41,
+	0x43, 0x4b, 0x45, 0x44, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52,
+	0x59, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f,
+	0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10,
+	0x05, 0x12, 0x14, 0x0a, 0x10, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x52, 0x45, 0x4c, 0x45,
+	0x41, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x48, 0x49, 0x53, 0x54, 0x4f,
+	0x52, 0x59, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x44, 0x10, 0x07, 0x12, 0x12, 0x0a, 0x0e,
+	0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x08,
+	0x12, 0x11, 0x0a, 0x0d, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x09, 0x12, 0x12, 0x0a, 0x0e, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x0a, 0x12, 0x14, 0x0a, 0x10, 0x48, 0x49, 0x53, 0x54, 0x4f,
+	0x52, 0x59, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x0b, 0x2a, 0xa0, 0x03,
+	0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a,
+	0x15, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x52, 0x45, 0x47, 0x49,
+	0x53, 0x54, 0x45, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x44, 0x45, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45,
+	0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x43, 0x4c,
+	0x49, 0x45, 0x4e, 0x54, 0x44, 0x45, 0x41, 0x44, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x04,
+	0x12, 0x16, 0x0a, 0x12, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x55,
+	0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x06,
+	0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x53, 0x54, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x07,
+	0x12, 0x1c, 0x0a, 0x18, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x53, 0x54, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x08, 0x12, 0x15,
+	0x0a, 0x11, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x4f, 0x46, 0x46, 0x45,
+	0x52, 0x45, 0x44, 0x10, 0x09, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x4c, 0x45,
+	0x41, 0x53, 0x45, 0x41, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x0a, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44,
+	0x10, 0x0b, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x4c, 0x45, 0x41, 0x53, 0x45,
+	0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x10, 0x0d, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x4c, 0x45, 0x41, 0x53, 0x45,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x0e,
+	0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x53, 0x54, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x0f,
+	0x32, 0xf5, 0x09, 0x0a, 0x0d, 0x56, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x42, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1e,
+	0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x14,
+	0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0a, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x14, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x50,
+	0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x1f, 0x2e, 0x76, 0x6f,
+	0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x48, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x20, 0x2e, 0x76,
+	0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x48, 0x65, 0x61,
+	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5e, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a,
+	0x1b, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x45, 0x0a, 0x0b, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x12,
+	0x1e, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x1a,
+	0x14, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x2e,
+	0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x6f, 0x6c,
+	0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x16, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x47, 0x61, 0x6e, 0x67, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x47, 0x61, 0x6e, 0x67, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x44, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x2e,
+	0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x14, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x56, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a,
+	0x14, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x22, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x14, 0x2e, 0x76, 0x6f,
+	0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x12, 0x23, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x14, 0x2e, 0x76, 0x6f, 0x6c, 0x63,
 	0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x50, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x1f,
-	0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x48,
-	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a,
+	0x00, 0x12, 0x53, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12,
 	0x20, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e,
-	0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x76, 0x6f, 0x6c, 0x63,
-	0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x1a, 0x1b, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x00, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0b, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
-	0x67, 0x65, 0x12, 0x1e, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x2e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x12, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1b, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
-	0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x00, 0x32, 0xd5, 0x03, 0x0a, 0x12, 0x56, 0x6f, 0x6c, 0x63, 0x68, 0x65,
-	0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x40, 0x0a, 0x0b,
-	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x2e, 0x76, 0x6f,
-	0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x19, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3d,
-	0x0a, 0x09, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x17, 0x2e, 0x76, 0x6f,
-	0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x49, 0x44, 0x1a, 0x15, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a,
-	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x76,
-	0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x19, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12,
-	0x3b, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x15, 0x2e, 0x76,
-	0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x1a, 0x15, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0c,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x15, 0x2e, 0x76,
-	0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x1a, 0x15, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0c,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x17, 0x2e, 0x76,
+	0x52, 0x65, 0x6e, 0x65, 0x77, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x1a, 0x21, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x76, 0x6f,
+	0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x6e, 0x65,
+	0x77, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x21, 0x2e,
+	0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x52, 0x65,
+	0x6e, 0x65, 0x77, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x12, 0x23, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x24, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68,
+	0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x56, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x18, 0x2e, 0x76,
+	0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x32, 0xa5, 0x06, 0x0a, 0x12, 0x56, 0x6f, 0x6c,
+	0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12,
+	0x40, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14,
+	0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22,
+	0x00, 0x12, 0x55, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x14, 0x2e, 0x76, 0x6f, 0x6c,
+	0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x24, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x17, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x1a, 0x15,
+	0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x76,
 	0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x49, 0x44, 0x1a, 0x14, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a,
-	0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x76, 0x6f,
-	0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x18, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x42, 0x27, 0x5a,
-	0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x30, 0x70, 0x72,
-	0x30, 0x63, 0x6b, 0x35, 0x2f, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x2f, 0x73, 0x76, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x09, 0x41, 0x64, 0x64,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x15, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x1a, 0x15, 0x2e,
+	0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x15, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x1a, 0x15, 0x2e,
+	0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x17, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x1a,
+	0x14, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x76, 0x6f,
+	0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6c, 0x61,
+	0x69, 0x6e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x1a, 0x26, 0x2e,
+	0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x2e, 0x76, 0x6f,
+	0x6c, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x1f, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68,
+	0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0b, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x76, 0x6f, 0x6c,
+	0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x14, 0x2e, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01,
+	0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
+	0x30, 0x70, 0x72, 0x30, 0x63, 0x6b, 0x35, 0x2f, 0x76, 0x6f, 0x6c, 0x63, 0x68, 0x65, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x73, 0x76, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1275,73 +4161,169 @@ func file_svc_volchestrator_proto_rawDescGZIP() []byte {
 	return file_svc_volchestrator_proto_rawDescData
 }
 
-var file_svc_volchestrator_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_svc_volchestrator_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_svc_volchestrator_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_svc_volchestrator_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_svc_volchestrator_proto_goTypes = []interface{}{
-	(NotificationType)(0),            // 0: volchestrator.NotificationType
-	(ClientStatus)(0),                // 1: volchestrator.ClientStatus
-	(VolumeStatus)(0),                // 2: volchestrator.VolumeStatus
-	(LeaseStatus)(0),                 // 3: volchestrator.LeaseStatus
-	(*RegisterMessage)(nil),          // 4: volchestrator.RegisterMessage
-	(*DeregisterMessage)(nil),        // 5: volchestrator.DeregisterMessage
-	(*HeartbeatMessage)(nil),         // 6: volchestrator.HeartbeatMessage
-	(*HeartbeatResponse)(nil),        // 7: volchestrator.HeartbeatResponse
-	(*LeaseRequest)(nil),             // 8: volchestrator.LeaseRequest
-	(*NotificationWatchMessage)(nil), // 9: volchestrator.NotificationWatchMessage
-	(*Notification)(nil),             // 10: volchestrator.Notification
-	(*Acknowledgement)(nil),          // 11: volchestrator.Acknowledgement
-	(*ClientInfo)(nil),               // 12: volchestrator.ClientInfo
-	(*ClientList)(nil),               // 13: volchestrator.ClientList
-	(*Empty)(nil),                    // 14: volchestrator.Empty
-	(*VolumeID)(nil),                 // 15: volchestrator.VolumeID
-	(*Volume)(nil),                   // 16: volchestrator.Volume
-	(*VolumeList)(nil),               // 17: volchestrator.VolumeList
-	(*Lease)(nil),                    // 18: volchestrator.Lease
-	(*LeaseList)(nil),                // 19: volchestrator.LeaseList
-	(*timestamppb.Timestamp)(nil),    // 20: google.protobuf.Timestamp
+	(NotificationType)(0),               // 0: volchestrator.NotificationType
+	(LeaseAccessMode)(0),                // 1: volchestrator.LeaseAccessMode
+	(ClientStatus)(0),                   // 2: volchestrator.ClientStatus
+	(VolumeStatus)(0),                   // 3: volchestrator.VolumeStatus
+	(LeaseStatus)(0),                    // 4: volchestrator.LeaseStatus
+	(HistoryState)(0),                   // 5: volchestrator.HistoryState
+	(EventType)(0),                      // 6: volchestrator.EventType
+	(*RegisterMessage)(nil),             // 7: volchestrator.RegisterMessage
+	(*DeregisterMessage)(nil),           // 8: volchestrator.DeregisterMessage
+	(*HeartbeatMessage)(nil),            // 9: volchestrator.HeartbeatMessage
+	(*HeartbeatResponse)(nil),           // 10: volchestrator.HeartbeatResponse
+	(*LeaseRequest)(nil),                // 11: volchestrator.LeaseRequest
+	(*GangLeaseRequest)(nil),            // 12: volchestrator.GangLeaseRequest
+	(*NotificationWatchMessage)(nil),    // 13: volchestrator.NotificationWatchMessage
+	(*Notification)(nil),                // 14: volchestrator.Notification
+	(*ClientRegisteredPayload)(nil),     // 15: volchestrator.ClientRegisteredPayload
+	(*LeaseRequestAckPayload)(nil),      // 16: volchestrator.LeaseRequestAckPayload
+	(*LeaseRequestExpiredPayload)(nil),  // 17: volchestrator.LeaseRequestExpiredPayload
+	(*LeaseAvailablePayload)(nil),       // 18: volchestrator.LeaseAvailablePayload
+	(*LeaseGrantedPayload)(nil),         // 19: volchestrator.LeaseGrantedPayload
+	(*LeaseRevokedPayload)(nil),         // 20: volchestrator.LeaseRevokedPayload
+	(*LeaseReleasedPayload)(nil),        // 21: volchestrator.LeaseReleasedPayload
+	(*LeaseTransferredPayload)(nil),     // 22: volchestrator.LeaseTransferredPayload
+	(*LeaseLifetimeWarningPayload)(nil), // 23: volchestrator.LeaseLifetimeWarningPayload
+	(*LeaseExpiringPayload)(nil),        // 24: volchestrator.LeaseExpiringPayload
+	(*Acknowledgement)(nil),             // 25: volchestrator.Acknowledgement
+	(*ReleaseLeaseMessage)(nil),         // 26: volchestrator.ReleaseLeaseMessage
+	(*ListClientLeasesMessage)(nil),     // 27: volchestrator.ListClientLeasesMessage
+	(*CancelLeaseRequestMessage)(nil),   // 28: volchestrator.CancelLeaseRequestMessage
+	(*UpdateLeaseRequestMessage)(nil),   // 29: volchestrator.UpdateLeaseRequestMessage
+	(*TransferLeaseMessage)(nil),        // 30: volchestrator.TransferLeaseMessage
+	(*RenewLeaseMessage)(nil),           // 31: volchestrator.RenewLeaseMessage
+	(*ValidateLeaseMessage)(nil),        // 32: volchestrator.ValidateLeaseMessage
+	(*ValidateLeaseResponse)(nil),       // 33: volchestrator.ValidateLeaseResponse
+	(*RenewLeaseResponse)(nil),          // 34: volchestrator.RenewLeaseResponse
+	(*ClientInfo)(nil),                  // 35: volchestrator.ClientInfo
+	(*ClientList)(nil),                  // 36: volchestrator.ClientList
+	(*Empty)(nil),                       // 37: volchestrator.Empty
+	(*VolumeID)(nil),                    // 38: volchestrator.VolumeID
+	(*Volume)(nil),                      // 39: volchestrator.Volume
+	(*VolumeList)(nil),                  // 40: volchestrator.VolumeList
+	(*Lease)(nil),                       // 41: volchestrator.Lease
+	(*LeaseList)(nil),                   // 42: volchestrator.LeaseList
+	(*LeaseRequestID)(nil),              // 43: volchestrator.LeaseRequestID
+	(*VolumeExplanation)(nil),           // 44: volchestrator.VolumeExplanation
+	(*LeaseRequestExplanation)(nil),     // 45: volchestrator.LeaseRequestExplanation
+	(*HistoryEvent)(nil),                // 46: volchestrator.HistoryEvent
+	(*HistoryEventList)(nil),            // 47: volchestrator.HistoryEventList
+	(*HistoryQuery)(nil),                // 48: volchestrator.HistoryQuery
+	(*NotificationStats)(nil),           // 49: volchestrator.NotificationStats
+	(*NotificationStatsList)(nil),       // 50: volchestrator.NotificationStatsList
+	(*Event)(nil),                       // 51: volchestrator.Event
+	(*EventFilter)(nil),                 // 52: volchestrator.EventFilter
+	(*durationpb.Duration)(nil),         // 53: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),       // 54: google.protobuf.Timestamp
 }
 var file_svc_volchestrator_proto_depIdxs = []int32{
-	0,  // 0: volchestrator.Notification.type:type_name -> volchestrator.NotificationType
-	1,  // 1: volchestrator.ClientInfo.clientStatus:type_name -> volchestrator.ClientStatus
-	20, // 2: volchestrator.ClientInfo.firstSeen:type_name -> google.protobuf.Timestamp
-	20, // 3: volchestrator.ClientInfo.lastSeen:type_name -> google.protobuf.Timestamp
-	12, // 4: volchestrator.ClientList.info:type_name -> volchestrator.ClientInfo
-	2,  // 5: volchestrator.Volume.status:type_name -> volchestrator.VolumeStatus
-	16, // 6: volchestrator.VolumeList.volumes:type_name -> volchestrator.Volume
-	20, // 7: volchestrator.Lease.expires:type_name -> google.protobuf.Timestamp
-	3,  // 8: volchestrator.Lease.status:type_name -> volchestrator.LeaseStatus
-	18, // 9: volchestrator.LeaseList.leases:type_name -> volchestrator.Lease
-	4,  // 10: volchestrator.Volchestrator.Register:input_type -> volchestrator.RegisterMessage
-	5,  // 11: volchestrator.Volchestrator.Deregister:input_type -> volchestrator.DeregisterMessage
-	6,  // 12: volchestrator.Volchestrator.Heartbeat:input_type -> volchestrator.HeartbeatMessage
-	9,  // 13: volchestrator.Volchestrator.WatchNotifications:input_type -> volchestrator.NotificationWatchMessage
-	11, // 14: volchestrator.Volchestrator.Acknowledge:input_type -> volchestrator.Acknowledgement
-	8,  // 15: volchestrator.Volchestrator.SubmitLeaseRequest:input_type -> volchestrator.LeaseRequest
-	14, // 16: volchestrator.VolchestratorAdmin.ListClients:input_type -> volchestrator.Empty
-	15, // 17: volchestrator.VolchestratorAdmin.GetVolume:input_type -> volchestrator.VolumeID
-	14, // 18: volchestrator.VolchestratorAdmin.ListVolumes:input_type -> volchestrator.Empty
-	16, // 19: volchestrator.VolchestratorAdmin.AddVolume:input_type -> volchestrator.Volume
-	16, // 20: volchestrator.VolchestratorAdmin.UpdateVolume:input_type -> volchestrator.Volume
-	15, // 21: volchestrator.VolchestratorAdmin.DeleteVolume:input_type -> volchestrator.VolumeID
-	14, // 22: volchestrator.VolchestratorAdmin.ListLeases:input_type -> volchestrator.Empty
-	14, // 23: volchestrator.Volchestrator.Register:output_type -> volchestrator.Empty
-	14, // 24: volchestrator.Volchestrator.Deregister:output_type -> volchestrator.Empty
-	7,  // 25: volchestrator.Volchestrator.Heartbeat:output_type -> volchestrator.HeartbeatResponse
-	10, // 26: volchestrator.Volchestrator.WatchNotifications:output_type -> volchestrator.Notification
-	14, // 27: volchestrator.Volchestrator.Acknowledge:output_type -> volchestrator.Empty
-	14, // 28: volchestrator.Volchestrator.SubmitLeaseRequest:output_type -> volchestrator.Empty
-	13, // 29: volchestrator.VolchestratorAdmin.ListClients:output_type -> volchestrator.ClientList
-	16, // 30: volchestrator.VolchestratorAdmin.GetVolume:output_type -> volchestrator.Volume
-	17, // 31: volchestrator.VolchestratorAdmin.ListVolumes:output_type -> volchestrator.VolumeList
-	16, // 32: volchestrator.VolchestratorAdmin.AddVolume:output_type -> volchestrator.Volume
-	16, // 33: volchestrator.VolchestratorAdmin.UpdateVolume:output_type -> volchestrator.Volume
-	14, // 34: volchestrator.VolchestratorAdmin.DeleteVolume:output_type -> volchestrator.Empty
-	19, // 35: volchestrator.VolchestratorAdmin.ListLeases:output_type -> volchestrator.LeaseList
-	23, // [23:36] is the sub-list for method output_type
-	10, // [10:23] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	53, // 0: volchestrator.LeaseRequest.ttl:type_name -> google.protobuf.Duration
+	1,  // 1: volchestrator.LeaseRequest.accessMode:type_name -> volchestrator.LeaseAccessMode
+	11, // 2: volchestrator.GangLeaseRequest.requests:type_name -> volchestrator.LeaseRequest
+	53, // 3: volchestrator.GangLeaseRequest.ttl:type_name -> google.protobuf.Duration
+	0,  // 4: volchestrator.Notification.type:type_name -> volchestrator.NotificationType
+	15, // 5: volchestrator.Notification.clientRegistered:type_name -> volchestrator.ClientRegisteredPayload
+	16, // 6: volchestrator.Notification.leaseRequestAck:type_name -> volchestrator.LeaseRequestAckPayload
+	17, // 7: volchestrator.Notification.leaseRequestExpired:type_name -> volchestrator.LeaseRequestExpiredPayload
+	18, // 8: volchestrator.Notification.leaseAvailable:type_name -> volchestrator.LeaseAvailablePayload
+	19, // 9: volchestrator.Notification.leaseGranted:type_name -> volchestrator.LeaseGrantedPayload
+	20, // 10: volchestrator.Notification.leaseRevoked:type_name -> volchestrator.LeaseRevokedPayload
+	21, // 11: volchestrator.Notification.leaseReleased:type_name -> volchestrator.LeaseReleasedPayload
+	22, // 12: volchestrator.Notification.leaseTransferred:type_name -> volchestrator.LeaseTransferredPayload
+	23, // 13: volchestrator.Notification.leaseLifetimeWarning:type_name -> volchestrator.LeaseLifetimeWarningPayload
+	24, // 14: volchestrator.Notification.leaseExpiring:type_name -> volchestrator.LeaseExpiringPayload
+	41, // 15: volchestrator.LeaseGrantedPayload.lease:type_name -> volchestrator.Lease
+	41, // 16: volchestrator.LeaseGrantedPayload.gangLeases:type_name -> volchestrator.Lease
+	53, // 17: volchestrator.LeaseRevokedPayload.gracePeriod:type_name -> google.protobuf.Duration
+	54, // 18: volchestrator.LeaseLifetimeWarningPayload.deadline:type_name -> google.protobuf.Timestamp
+	54, // 19: volchestrator.LeaseExpiringPayload.expires:type_name -> google.protobuf.Timestamp
+	53, // 20: volchestrator.RenewLeaseResponse.ttl:type_name -> google.protobuf.Duration
+	54, // 21: volchestrator.RenewLeaseResponse.expires:type_name -> google.protobuf.Timestamp
+	2,  // 22: volchestrator.ClientInfo.clientStatus:type_name -> volchestrator.ClientStatus
+	54, // 23: volchestrator.ClientInfo.firstSeen:type_name -> google.protobuf.Timestamp
+	54, // 24: volchestrator.ClientInfo.lastSeen:type_name -> google.protobuf.Timestamp
+	35, // 25: volchestrator.ClientList.info:type_name -> volchestrator.ClientInfo
+	3,  // 26: volchestrator.Volume.status:type_name -> volchestrator.VolumeStatus
+	39, // 27: volchestrator.VolumeList.volumes:type_name -> volchestrator.Volume
+	54, // 28: volchestrator.Lease.expires:type_name -> google.protobuf.Timestamp
+	4,  // 29: volchestrator.Lease.status:type_name -> volchestrator.LeaseStatus
+	53, // 30: volchestrator.Lease.ttl:type_name -> google.protobuf.Duration
+	1,  // 31: volchestrator.Lease.accessMode:type_name -> volchestrator.LeaseAccessMode
+	54, // 32: volchestrator.Lease.acquired:type_name -> google.protobuf.Timestamp
+	54, // 33: volchestrator.Lease.deadline:type_name -> google.protobuf.Timestamp
+	41, // 34: volchestrator.LeaseList.leases:type_name -> volchestrator.Lease
+	44, // 35: volchestrator.LeaseRequestExplanation.volumes:type_name -> volchestrator.VolumeExplanation
+	45, // 36: volchestrator.LeaseRequestExplanation.members:type_name -> volchestrator.LeaseRequestExplanation
+	54, // 37: volchestrator.HistoryEvent.time:type_name -> google.protobuf.Timestamp
+	5,  // 38: volchestrator.HistoryEvent.state:type_name -> volchestrator.HistoryState
+	46, // 39: volchestrator.HistoryEventList.events:type_name -> volchestrator.HistoryEvent
+	54, // 40: volchestrator.HistoryQuery.since:type_name -> google.protobuf.Timestamp
+	54, // 41: volchestrator.HistoryQuery.until:type_name -> google.protobuf.Timestamp
+	49, // 42: volchestrator.NotificationStatsList.stats:type_name -> volchestrator.NotificationStats
+	54, // 43: volchestrator.Event.time:type_name -> google.protobuf.Timestamp
+	6,  // 44: volchestrator.Event.type:type_name -> volchestrator.EventType
+	6,  // 45: volchestrator.EventFilter.types:type_name -> volchestrator.EventType
+	7,  // 46: volchestrator.Volchestrator.Register:input_type -> volchestrator.RegisterMessage
+	8,  // 47: volchestrator.Volchestrator.Deregister:input_type -> volchestrator.DeregisterMessage
+	9,  // 48: volchestrator.Volchestrator.Heartbeat:input_type -> volchestrator.HeartbeatMessage
+	13, // 49: volchestrator.Volchestrator.WatchNotifications:input_type -> volchestrator.NotificationWatchMessage
+	25, // 50: volchestrator.Volchestrator.Acknowledge:input_type -> volchestrator.Acknowledgement
+	11, // 51: volchestrator.Volchestrator.SubmitLeaseRequest:input_type -> volchestrator.LeaseRequest
+	12, // 52: volchestrator.Volchestrator.SubmitGangLeaseRequest:input_type -> volchestrator.GangLeaseRequest
+	28, // 53: volchestrator.Volchestrator.CancelLeaseRequest:input_type -> volchestrator.CancelLeaseRequestMessage
+	29, // 54: volchestrator.Volchestrator.UpdateLeaseRequest:input_type -> volchestrator.UpdateLeaseRequestMessage
+	26, // 55: volchestrator.Volchestrator.ReleaseLease:input_type -> volchestrator.ReleaseLeaseMessage
+	30, // 56: volchestrator.Volchestrator.TransferLease:input_type -> volchestrator.TransferLeaseMessage
+	31, // 57: volchestrator.Volchestrator.RenewLease:input_type -> volchestrator.RenewLeaseMessage
+	31, // 58: volchestrator.Volchestrator.RenewLeaseRequest:input_type -> volchestrator.RenewLeaseMessage
+	32, // 59: volchestrator.Volchestrator.ValidateLease:input_type -> volchestrator.ValidateLeaseMessage
+	27, // 60: volchestrator.Volchestrator.ListClientLeases:input_type -> volchestrator.ListClientLeasesMessage
+	37, // 61: volchestrator.VolchestratorAdmin.ListClients:input_type -> volchestrator.Empty
+	37, // 62: volchestrator.VolchestratorAdmin.ListNotificationStats:input_type -> volchestrator.Empty
+	38, // 63: volchestrator.VolchestratorAdmin.GetVolume:input_type -> volchestrator.VolumeID
+	37, // 64: volchestrator.VolchestratorAdmin.ListVolumes:input_type -> volchestrator.Empty
+	39, // 65: volchestrator.VolchestratorAdmin.AddVolume:input_type -> volchestrator.Volume
+	39, // 66: volchestrator.VolchestratorAdmin.UpdateVolume:input_type -> volchestrator.Volume
+	38, // 67: volchestrator.VolchestratorAdmin.DeleteVolume:input_type -> volchestrator.VolumeID
+	37, // 68: volchestrator.VolchestratorAdmin.ListLeases:input_type -> volchestrator.Empty
+	43, // 69: volchestrator.VolchestratorAdmin.ExplainLeaseRequest:input_type -> volchestrator.LeaseRequestID
+	48, // 70: volchestrator.VolchestratorAdmin.ListLeaseHistory:input_type -> volchestrator.HistoryQuery
+	52, // 71: volchestrator.VolchestratorAdmin.WatchEvents:input_type -> volchestrator.EventFilter
+	37, // 72: volchestrator.Volchestrator.Register:output_type -> volchestrator.Empty
+	37, // 73: volchestrator.Volchestrator.Deregister:output_type -> volchestrator.Empty
+	10, // 74: volchestrator.Volchestrator.Heartbeat:output_type -> volchestrator.HeartbeatResponse
+	14, // 75: volchestrator.Volchestrator.WatchNotifications:output_type -> volchestrator.Notification
+	37, // 76: volchestrator.Volchestrator.Acknowledge:output_type -> volchestrator.Empty
+	43, // 77: volchestrator.Volchestrator.SubmitLeaseRequest:output_type -> volchestrator.LeaseRequestID
+	43, // 78: volchestrator.Volchestrator.SubmitGangLeaseRequest:output_type -> volchestrator.LeaseRequestID
+	37, // 79: volchestrator.Volchestrator.CancelLeaseRequest:output_type -> volchestrator.Empty
+	37, // 80: volchestrator.Volchestrator.UpdateLeaseRequest:output_type -> volchestrator.Empty
+	37, // 81: volchestrator.Volchestrator.ReleaseLease:output_type -> volchestrator.Empty
+	37, // 82: volchestrator.Volchestrator.TransferLease:output_type -> volchestrator.Empty
+	34, // 83: volchestrator.Volchestrator.RenewLease:output_type -> volchestrator.RenewLeaseResponse
+	34, // 84: volchestrator.Volchestrator.RenewLeaseRequest:output_type -> volchestrator.RenewLeaseResponse
+	33, // 85: volchestrator.Volchestrator.ValidateLease:output_type -> volchestrator.ValidateLeaseResponse
+	42, // 86: volchestrator.Volchestrator.ListClientLeases:output_type -> volchestrator.LeaseList
+	36, // 87: volchestrator.VolchestratorAdmin.ListClients:output_type -> volchestrator.ClientList
+	50, // 88: volchestrator.VolchestratorAdmin.ListNotificationStats:output_type -> volchestrator.NotificationStatsList
+	39, // 89: volchestrator.VolchestratorAdmin.GetVolume:output_type -> volchestrator.Volume
+	40, // 90: volchestrator.VolchestratorAdmin.ListVolumes:output_type -> volchestrator.VolumeList
+	39, // 91: volchestrator.VolchestratorAdmin.AddVolume:output_type -> volchestrator.Volume
+	39, // 92: volchestrator.VolchestratorAdmin.UpdateVolume:output_type -> volchestrator.Volume
+	37, // 93: volchestrator.VolchestratorAdmin.DeleteVolume:output_type -> volchestrator.Empty
+	42, // 94: volchestrator.VolchestratorAdmin.ListLeases:output_type -> volchestrator.LeaseList
+	45, // 95: volchestrator.VolchestratorAdmin.ExplainLeaseRequest:output_type -> volchestrator.LeaseRequestExplanation
+	47, // 96: volchestrator.VolchestratorAdmin.ListLeaseHistory:output_type -> volchestrator.HistoryEventList
+	51, // 97: volchestrator.VolchestratorAdmin.WatchEvents:output_type -> volchestrator.Event
+	72, // [72:98] is the sub-list for method output_type
+	46, // [46:72] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
 }
 
 func init() { file_svc_volchestrator_proto_init() }
@@ -1362,8 +4344,104 @@ func file_svc_volchestrator_proto_init() {
 				return nil
 			}
 		}
-		file_svc_volchestrator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeregisterMessage); i {
+		file_svc_volchestrator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeregisterMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GangLeaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationWatchMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Notification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientRegisteredPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseRequestAckPayload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1374,8 +4452,8 @@ func file_svc_volchestrator_proto_init() {
 				return nil
 			}
 		}
-		file_svc_volchestrator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeartbeatMessage); i {
+		file_svc_volchestrator_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseRequestExpiredPayload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1386,8 +4464,8 @@ func file_svc_volchestrator_proto_init() {
 				return nil
 			}
 		}
-		file_svc_volchestrator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeartbeatResponse); i {
+		file_svc_volchestrator_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseAvailablePayload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1398,8 +4476,8 @@ func file_svc_volchestrator_proto_init() {
 				return nil
 			}
 		}
-		file_svc_volchestrator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaseRequest); i {
+		file_svc_volchestrator_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseGrantedPayload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1410,8 +4488,8 @@ func file_svc_volchestrator_proto_init() {
 				return nil
 			}
 		}
-		file_svc_volchestrator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotificationWatchMessage); i {
+		file_svc_volchestrator_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseRevokedPayload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1422,8 +4500,8 @@ func file_svc_volchestrator_proto_init() {
 				return nil
 			}
 		}
-		file_svc_volchestrator_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Notification); i {
+		file_svc_volchestrator_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseReleasedPayload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1434,7 +4512,43 @@ func file_svc_volchestrator_proto_init() {
 				return nil
 			}
 		}
-		file_svc_volchestrator_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_svc_volchestrator_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseTransferredPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseLifetimeWarningPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseExpiringPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Acknowledgement); i {
 			case 0:
 				return &v.state
@@ -1446,7 +4560,115 @@ func file_svc_volchestrator_proto_init() {
 				return nil
 			}
 		}
-		file_svc_volchestrator_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_svc_volchestrator_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseLeaseMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListClientLeasesMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelLeaseRequestMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLeaseRequestMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferLeaseMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewLeaseMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateLeaseMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateLeaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewLeaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClientInfo); i {
 			case 0:
 				return &v.state
@@ -1458,7 +4680,7 @@ func file_svc_volchestrator_proto_init() {
 				return nil
 			}
 		}
-		file_svc_volchestrator_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_svc_volchestrator_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClientList); i {
 			case 0:
 				return &v.state
@@ -1470,7 +4692,7 @@ func file_svc_volchestrator_proto_init() {
 				return nil
 			}
 		}
-		file_svc_volchestrator_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_svc_volchestrator_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Empty); i {
 			case 0:
 				return &v.state
@@ -1482,7 +4704,7 @@ func file_svc_volchestrator_proto_init() {
 				return nil
 			}
 		}
-		file_svc_volchestrator_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_svc_volchestrator_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VolumeID); i {
 			case 0:
 				return &v.state
@@ -1494,7 +4716,7 @@ func file_svc_volchestrator_proto_init() {
 				return nil
 			}
 		}
-		file_svc_volchestrator_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_svc_volchestrator_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Volume); i {
 			case 0:
 				return &v.state
@@ -1506,7 +4728,7 @@ func file_svc_volchestrator_proto_init() {
 				return nil
 			}
 		}
-		file_svc_volchestrator_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_svc_volchestrator_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VolumeList); i {
 			case 0:
 				return &v.state
@@ -1518,7 +4740,7 @@ func file_svc_volchestrator_proto_init() {
 				return nil
 			}
 		}
-		file_svc_volchestrator_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_svc_volchestrator_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Lease); i {
 			case 0:
 				return &v.state
@@ -1530,7 +4752,7 @@ func file_svc_volchestrator_proto_init() {
 				return nil
 			}
 		}
-		file_svc_volchestrator_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_svc_volchestrator_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LeaseList); i {
 			case 0:
 				return &v.state
@@ -1542,14 +4764,146 @@ func file_svc_volchestrator_proto_init() {
 				return nil
 			}
 		}
+		file_svc_volchestrator_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseRequestID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeExplanation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseRequestExplanation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoryEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoryEventList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoryQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationStatsList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_svc_volchestrator_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_svc_volchestrator_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*Notification_ClientRegistered)(nil),
+		(*Notification_LeaseRequestAck)(nil),
+		(*Notification_LeaseRequestExpired)(nil),
+		(*Notification_LeaseAvailable)(nil),
+		(*Notification_LeaseGranted)(nil),
+		(*Notification_LeaseRevoked)(nil),
+		(*Notification_LeaseReleased)(nil),
+		(*Notification_LeaseTransferred)(nil),
+		(*Notification_LeaseLifetimeWarning)(nil),
+		(*Notification_LeaseExpiring)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_svc_volchestrator_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   16,
+			NumEnums:      7,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -22,7 +22,16 @@ type VolchestratorClient interface {
 	Heartbeat(ctx context.Context, in *HeartbeatMessage, opts ...grpc.CallOption) (*HeartbeatResponse, error)
 	WatchNotifications(ctx context.Context, in *NotificationWatchMessage, opts ...grpc.CallOption) (Volchestrator_WatchNotificationsClient, error)
 	Acknowledge(ctx context.Context, in *Acknowledgement, opts ...grpc.CallOption) (*Empty, error)
-	SubmitLeaseRequest(ctx context.Context, in *LeaseRequest, opts ...grpc.CallOption) (*Empty, error)
+	SubmitLeaseRequest(ctx context.Context, in *LeaseRequest, opts ...grpc.CallOption) (*LeaseRequestID, error)
+	SubmitGangLeaseRequest(ctx context.Context, in *GangLeaseRequest, opts ...grpc.CallOption) (*LeaseRequestID, error)
+	CancelLeaseRequest(ctx context.Context, in *CancelLeaseRequestMessage, opts ...grpc.CallOption) (*Empty, error)
+	UpdateLeaseRequest(ctx context.Context, in *UpdateLeaseRequestMessage, opts ...grpc.CallOption) (*Empty, error)
+	ReleaseLease(ctx context.Context, in *ReleaseLeaseMessage, opts ...grpc.CallOption) (*Empty, error)
+	TransferLease(ctx context.Context, in *TransferLeaseMessage, opts ...grpc.CallOption) (*Empty, error)
+	RenewLease(ctx context.Context, in *RenewLeaseMessage, opts ...grpc.CallOption) (*RenewLeaseResponse, error)
+	RenewLeaseRequest(ctx context.Context, in *RenewLeaseMessage, opts ...grpc.CallOption) (*RenewLeaseResponse, error)
+	ValidateLease(ctx context.Context, in *ValidateLeaseMessage, opts ...grpc.CallOption) (*ValidateLeaseResponse, error)
+	ListClientLeases(ctx context.Context, in *ListClientLeasesMessage, opts ...grpc.CallOption) (*LeaseList, error)
 }
 
 type volchestratorClient struct {
@@ -101,8 +110,8 @@ func (c *volchestratorClient) Acknowledge(ctx context.Context, in *Acknowledgeme
 	return out, nil
 }
 
-func (c *volchestratorClient) SubmitLeaseRequest(ctx context.Context, in *LeaseRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
+func (c *volchestratorClient) SubmitLeaseRequest(ctx context.Context, in *LeaseRequest, opts ...grpc.CallOption) (*LeaseRequestID, error) {
+	out := new(LeaseRequestID)
 	err := c.cc.Invoke(ctx, "/volchestrator.Volchestrator/SubmitLeaseRequest", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -110,6 +119,87 @@ func (c *volchestratorClient) SubmitLeaseRequest(ctx context.Context, in *LeaseR
 	return out, nil
 }
 
+func (c *volchestratorClient) SubmitGangLeaseRequest(ctx context.Context, in *GangLeaseRequest, opts ...grpc.CallOption) (*LeaseRequestID, error) {
+	out := new(LeaseRequestID)
+	err := c.cc.Invoke(ctx, "/volchestrator.Volchestrator/SubmitGangLeaseRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volchestratorClient) CancelLeaseRequest(ctx context.Context, in *CancelLeaseRequestMessage, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/volchestrator.Volchestrator/CancelLeaseRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volchestratorClient) UpdateLeaseRequest(ctx context.Context, in *UpdateLeaseRequestMessage, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/volchestrator.Volchestrator/UpdateLeaseRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volchestratorClient) ReleaseLease(ctx context.Context, in *ReleaseLeaseMessage, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/volchestrator.Volchestrator/ReleaseLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volchestratorClient) TransferLease(ctx context.Context, in *TransferLeaseMessage, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/volchestrator.Volchestrator/TransferLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volchestratorClient) RenewLease(ctx context.Context, in *RenewLeaseMessage, opts ...grpc.CallOption) (*RenewLeaseResponse, error) {
+	out := new(RenewLeaseResponse)
+	err := c.cc.Invoke(ctx, "/volchestrator.Volchestrator/RenewLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volchestratorClient) RenewLeaseRequest(ctx context.Context, in *RenewLeaseMessage, opts ...grpc.CallOption) (*RenewLeaseResponse, error) {
+	out := new(RenewLeaseResponse)
+	err := c.cc.Invoke(ctx, "/volchestrator.Volchestrator/RenewLeaseRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volchestratorClient) ValidateLease(ctx context.Context, in *ValidateLeaseMessage, opts ...grpc.CallOption) (*ValidateLeaseResponse, error) {
+	out := new(ValidateLeaseResponse)
+	err := c.cc.Invoke(ctx, "/volchestrator.Volchestrator/ValidateLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volchestratorClient) ListClientLeases(ctx context.Context, in *ListClientLeasesMessage, opts ...grpc.CallOption) (*LeaseList, error) {
+	out := new(LeaseList)
+	err := c.cc.Invoke(ctx, "/volchestrator.Volchestrator/ListClientLeases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VolchestratorServer is the server API for Volchestrator service.
 // All implementations must embed UnimplementedVolchestratorServer
 // for forward compatibility
@@ -119,7 +209,16 @@ type VolchestratorServer interface {
 	Heartbeat(context.Context, *HeartbeatMessage) (*HeartbeatResponse, error)
 	WatchNotifications(*NotificationWatchMessage, Volchestrator_WatchNotificationsServer) error
 	Acknowledge(context.Context, *Acknowledgement) (*Empty, error)
-	SubmitLeaseRequest(context.Context, *LeaseRequest) (*Empty, error)
+	SubmitLeaseRequest(context.Context, *LeaseRequest) (*LeaseRequestID, error)
+	SubmitGangLeaseRequest(context.Context, *GangLeaseRequest) (*LeaseRequestID, error)
+	CancelLeaseRequest(context.Context, *CancelLeaseRequestMessage) (*Empty, error)
+	UpdateLeaseRequest(context.Context, *UpdateLeaseRequestMessage) (*Empty, error)
+	ReleaseLease(context.Context, *ReleaseLeaseMessage) (*Empty, error)
+	TransferLease(context.Context, *TransferLeaseMessage) (*Empty, error)
+	RenewLease(context.Context, *RenewLeaseMessage) (*RenewLeaseResponse, error)
+	RenewLeaseRequest(context.Context, *RenewLeaseMessage) (*RenewLeaseResponse, error)
+	ValidateLease(context.Context, *ValidateLeaseMessage) (*ValidateLeaseResponse, error)
+	ListClientLeases(context.Context, *ListClientLeasesMessage) (*LeaseList, error)
 	mustEmbedUnimplementedVolchestratorServer()
 }
 
@@ -142,9 +241,36 @@ func (UnimplementedVolchestratorServer) WatchNotifications(*NotificationWatchMes
 func (UnimplementedVolchestratorServer) Acknowledge(context.Context, *Acknowledgement) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Acknowledge not implemented")
 }
-func (UnimplementedVolchestratorServer) SubmitLeaseRequest(context.Context, *LeaseRequest) (*Empty, error) {
+func (UnimplementedVolchestratorServer) SubmitLeaseRequest(context.Context, *LeaseRequest) (*LeaseRequestID, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitLeaseRequest not implemented")
 }
+func (UnimplementedVolchestratorServer) SubmitGangLeaseRequest(context.Context, *GangLeaseRequest) (*LeaseRequestID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitGangLeaseRequest not implemented")
+}
+func (UnimplementedVolchestratorServer) CancelLeaseRequest(context.Context, *CancelLeaseRequestMessage) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLeaseRequest not implemented")
+}
+func (UnimplementedVolchestratorServer) UpdateLeaseRequest(context.Context, *UpdateLeaseRequestMessage) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLeaseRequest not implemented")
+}
+func (UnimplementedVolchestratorServer) ReleaseLease(context.Context, *ReleaseLeaseMessage) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseLease not implemented")
+}
+func (UnimplementedVolchestratorServer) TransferLease(context.Context, *TransferLeaseMessage) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLease not implemented")
+}
+func (UnimplementedVolchestratorServer) RenewLease(context.Context, *RenewLeaseMessage) (*RenewLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewLease not implemented")
+}
+func (UnimplementedVolchestratorServer) RenewLeaseRequest(context.Context, *RenewLeaseMessage) (*RenewLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewLeaseRequest not implemented")
+}
+func (UnimplementedVolchestratorServer) ValidateLease(context.Context, *ValidateLeaseMessage) (*ValidateLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateLease not implemented")
+}
+func (UnimplementedVolchestratorServer) ListClientLeases(context.Context, *ListClientLeasesMessage) (*LeaseList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientLeases not implemented")
+}
 func (UnimplementedVolchestratorServer) mustEmbedUnimplementedVolchestratorServer() {}
 
 // UnsafeVolchestratorServer may be embedded to opt out of forward compatibility for this service.
@@ -269,6 +395,168 @@ func _Volchestrator_SubmitLeaseRequest_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Volchestrator_SubmitGangLeaseRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GangLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolchestratorServer).SubmitGangLeaseRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volchestrator.Volchestrator/SubmitGangLeaseRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolchestratorServer).SubmitGangLeaseRequest(ctx, req.(*GangLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volchestrator_CancelLeaseRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelLeaseRequestMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolchestratorServer).CancelLeaseRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volchestrator.Volchestrator/CancelLeaseRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolchestratorServer).CancelLeaseRequest(ctx, req.(*CancelLeaseRequestMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volchestrator_UpdateLeaseRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLeaseRequestMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolchestratorServer).UpdateLeaseRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volchestrator.Volchestrator/UpdateLeaseRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolchestratorServer).UpdateLeaseRequest(ctx, req.(*UpdateLeaseRequestMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volchestrator_ReleaseLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseLeaseMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolchestratorServer).ReleaseLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volchestrator.Volchestrator/ReleaseLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolchestratorServer).ReleaseLease(ctx, req.(*ReleaseLeaseMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volchestrator_TransferLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferLeaseMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolchestratorServer).TransferLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volchestrator.Volchestrator/TransferLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolchestratorServer).TransferLease(ctx, req.(*TransferLeaseMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volchestrator_RenewLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewLeaseMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolchestratorServer).RenewLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volchestrator.Volchestrator/RenewLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolchestratorServer).RenewLease(ctx, req.(*RenewLeaseMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volchestrator_RenewLeaseRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewLeaseMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolchestratorServer).RenewLeaseRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volchestrator.Volchestrator/RenewLeaseRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolchestratorServer).RenewLeaseRequest(ctx, req.(*RenewLeaseMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volchestrator_ValidateLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateLeaseMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolchestratorServer).ValidateLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volchestrator.Volchestrator/ValidateLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolchestratorServer).ValidateLease(ctx, req.(*ValidateLeaseMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volchestrator_ListClientLeases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientLeasesMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolchestratorServer).ListClientLeases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volchestrator.Volchestrator/ListClientLeases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolchestratorServer).ListClientLeases(ctx, req.(*ListClientLeasesMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Volchestrator_ServiceDesc is the grpc.ServiceDesc for Volchestrator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -296,6 +584,42 @@ var Volchestrator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitLeaseRequest",
 			Handler:    _Volchestrator_SubmitLeaseRequest_Handler,
 		},
+		{
+			MethodName: "SubmitGangLeaseRequest",
+			Handler:    _Volchestrator_SubmitGangLeaseRequest_Handler,
+		},
+		{
+			MethodName: "CancelLeaseRequest",
+			Handler:    _Volchestrator_CancelLeaseRequest_Handler,
+		},
+		{
+			MethodName: "UpdateLeaseRequest",
+			Handler:    _Volchestrator_UpdateLeaseRequest_Handler,
+		},
+		{
+			MethodName: "ReleaseLease",
+			Handler:    _Volchestrator_ReleaseLease_Handler,
+		},
+		{
+			MethodName: "TransferLease",
+			Handler:    _Volchestrator_TransferLease_Handler,
+		},
+		{
+			MethodName: "RenewLease",
+			Handler:    _Volchestrator_RenewLease_Handler,
+		},
+		{
+			MethodName: "RenewLeaseRequest",
+			Handler:    _Volchestrator_RenewLeaseRequest_Handler,
+		},
+		{
+			MethodName: "ValidateLease",
+			Handler:    _Volchestrator_ValidateLease_Handler,
+		},
+		{
+			MethodName: "ListClientLeases",
+			Handler:    _Volchestrator_ListClientLeases_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -312,12 +636,16 @@ var Volchestrator_ServiceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type VolchestratorAdminClient interface {
 	ListClients(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ClientList, error)
+	ListNotificationStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NotificationStatsList, error)
 	GetVolume(ctx context.Context, in *VolumeID, opts ...grpc.CallOption) (*Volume, error)
 	ListVolumes(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*VolumeList, error)
 	AddVolume(ctx context.Context, in *Volume, opts ...grpc.CallOption) (*Volume, error)
 	UpdateVolume(ctx context.Context, in *Volume, opts ...grpc.CallOption) (*Volume, error)
 	DeleteVolume(ctx context.Context, in *VolumeID, opts ...grpc.CallOption) (*Empty, error)
 	ListLeases(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*LeaseList, error)
+	ExplainLeaseRequest(ctx context.Context, in *LeaseRequestID, opts ...grpc.CallOption) (*LeaseRequestExplanation, error)
+	ListLeaseHistory(ctx context.Context, in *HistoryQuery, opts ...grpc.CallOption) (*HistoryEventList, error)
+	WatchEvents(ctx context.Context, in *EventFilter, opts ...grpc.CallOption) (VolchestratorAdmin_WatchEventsClient, error)
 }
 
 type volchestratorAdminClient struct {
@@ -337,6 +665,15 @@ func (c *volchestratorAdminClient) ListClients(ctx context.Context, in *Empty, o
 	return out, nil
 }
 
+func (c *volchestratorAdminClient) ListNotificationStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NotificationStatsList, error) {
+	out := new(NotificationStatsList)
+	err := c.cc.Invoke(ctx, "/volchestrator.VolchestratorAdmin/ListNotificationStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *volchestratorAdminClient) GetVolume(ctx context.Context, in *VolumeID, opts ...grpc.CallOption) (*Volume, error) {
 	out := new(Volume)
 	err := c.cc.Invoke(ctx, "/volchestrator.VolchestratorAdmin/GetVolume", in, out, opts...)
@@ -391,17 +728,71 @@ func (c *volchestratorAdminClient) ListLeases(ctx context.Context, in *Empty, op
 	return out, nil
 }
 
+func (c *volchestratorAdminClient) ExplainLeaseRequest(ctx context.Context, in *LeaseRequestID, opts ...grpc.CallOption) (*LeaseRequestExplanation, error) {
+	out := new(LeaseRequestExplanation)
+	err := c.cc.Invoke(ctx, "/volchestrator.VolchestratorAdmin/ExplainLeaseRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volchestratorAdminClient) ListLeaseHistory(ctx context.Context, in *HistoryQuery, opts ...grpc.CallOption) (*HistoryEventList, error) {
+	out := new(HistoryEventList)
+	err := c.cc.Invoke(ctx, "/volchestrator.VolchestratorAdmin/ListLeaseHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volchestratorAdminClient) WatchEvents(ctx context.Context, in *EventFilter, opts ...grpc.CallOption) (VolchestratorAdmin_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &VolchestratorAdmin_ServiceDesc.Streams[0], "/volchestrator.VolchestratorAdmin/WatchEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &volchestratorAdminWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VolchestratorAdmin_WatchEventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type volchestratorAdminWatchEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *volchestratorAdminWatchEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // VolchestratorAdminServer is the server API for VolchestratorAdmin service.
 // All implementations must embed UnimplementedVolchestratorAdminServer
 // for forward compatibility
 type VolchestratorAdminServer interface {
 	ListClients(context.Context, *Empty) (*ClientList, error)
+	ListNotificationStats(context.Context, *Empty) (*NotificationStatsList, error)
 	GetVolume(context.Context, *VolumeID) (*Volume, error)
 	ListVolumes(context.Context, *Empty) (*VolumeList, error)
 	AddVolume(context.Context, *Volume) (*Volume, error)
 	UpdateVolume(context.Context, *Volume) (*Volume, error)
 	DeleteVolume(context.Context, *VolumeID) (*Empty, error)
 	ListLeases(context.Context, *Empty) (*LeaseList, error)
+	ExplainLeaseRequest(context.Context, *LeaseRequestID) (*LeaseRequestExplanation, error)
+	ListLeaseHistory(context.Context, *HistoryQuery) (*HistoryEventList, error)
+	WatchEvents(*EventFilter, VolchestratorAdmin_WatchEventsServer) error
 	mustEmbedUnimplementedVolchestratorAdminServer()
 }
 
@@ -412,6 +803,9 @@ type UnimplementedVolchestratorAdminServer struct {
 func (UnimplementedVolchestratorAdminServer) ListClients(context.Context, *Empty) (*ClientList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListClients not implemented")
 }
+func (UnimplementedVolchestratorAdminServer) ListNotificationStats(context.Context, *Empty) (*NotificationStatsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotificationStats not implemented")
+}
 func (UnimplementedVolchestratorAdminServer) GetVolume(context.Context, *VolumeID) (*Volume, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVolume not implemented")
 }
@@ -430,6 +824,15 @@ func (UnimplementedVolchestratorAdminServer) DeleteVolume(context.Context, *Volu
 func (UnimplementedVolchestratorAdminServer) ListLeases(context.Context, *Empty) (*LeaseList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLeases not implemented")
 }
+func (UnimplementedVolchestratorAdminServer) ExplainLeaseRequest(context.Context, *LeaseRequestID) (*LeaseRequestExplanation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainLeaseRequest not implemented")
+}
+func (UnimplementedVolchestratorAdminServer) ListLeaseHistory(context.Context, *HistoryQuery) (*HistoryEventList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLeaseHistory not implemented")
+}
+func (UnimplementedVolchestratorAdminServer) WatchEvents(*EventFilter, VolchestratorAdmin_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
 func (UnimplementedVolchestratorAdminServer) mustEmbedUnimplementedVolchestratorAdminServer() {}
 
 // UnsafeVolchestratorAdminServer may be embedded to opt out of forward compatibility for this service.
@@ -461,6 +864,24 @@ func _VolchestratorAdmin_ListClients_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VolchestratorAdmin_ListNotificationStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolchestratorAdminServer).ListNotificationStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volchestrator.VolchestratorAdmin/ListNotificationStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolchestratorAdminServer).ListNotificationStats(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VolchestratorAdmin_GetVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VolumeID)
 	if err := dec(in); err != nil {
@@ -569,6 +990,63 @@ func _VolchestratorAdmin_ListLeases_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VolchestratorAdmin_ExplainLeaseRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseRequestID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolchestratorAdminServer).ExplainLeaseRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volchestrator.VolchestratorAdmin/ExplainLeaseRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolchestratorAdminServer).ExplainLeaseRequest(ctx, req.(*LeaseRequestID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolchestratorAdmin_ListLeaseHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolchestratorAdminServer).ListLeaseHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volchestrator.VolchestratorAdmin/ListLeaseHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolchestratorAdminServer).ListLeaseHistory(ctx, req.(*HistoryQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolchestratorAdmin_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VolchestratorAdminServer).WatchEvents(m, &volchestratorAdminWatchEventsServer{stream})
+}
+
+type VolchestratorAdmin_WatchEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type volchestratorAdminWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *volchestratorAdminWatchEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // VolchestratorAdmin_ServiceDesc is the grpc.ServiceDesc for VolchestratorAdmin service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -580,6 +1058,10 @@ var VolchestratorAdmin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListClients",
 			Handler:    _VolchestratorAdmin_ListClients_Handler,
 		},
+		{
+			MethodName: "ListNotificationStats",
+			Handler:    _VolchestratorAdmin_ListNotificationStats_Handler,
+		},
 		{
 			MethodName: "GetVolume",
 			Handler:    _VolchestratorAdmin_GetVolume_Handler,
@@ -604,7 +1086,21 @@ var VolchestratorAdmin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListLeases",
 			Handler:    _VolchestratorAdmin_ListLeases_Handler,
 		},
+		{
+			MethodName: "ExplainLeaseRequest",
+			Handler:    _VolchestratorAdmin_ExplainLeaseRequest_Handler,
+		},
+		{
+			MethodName: "ListLeaseHistory",
+			Handler:    _VolchestratorAdmin_ListLeaseHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _VolchestratorAdmin_WatchEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "svc/volchestrator.proto",
 }